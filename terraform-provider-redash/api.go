@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// apiRequest performs a request against the Redash API for endpoints which are
+// not (yet) exposed by the redash client library. The payload, if any, is sent
+// as JSON and the response body is decoded into result when it is not nil.
+func apiRequest(c *redash.Client, method string, path string, payload interface{}, query url.Values, result interface{}) error {
+	requestURI := strings.TrimSuffix(c.Config.RedashURI, "/") + path
+
+	body := ""
+	if payload != nil {
+		rawPayload, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = string(rawPayload)
+	}
+
+	request, err := http.NewRequest(method, requestURI, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Key "+c.Config.APIKey)
+	if query != nil {
+		request.URL.RawQuery = query.Encode()
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("%d from %s request to %s: %s", response.StatusCode, method, requestURI, string(responseBody))
+	}
+
+	if result == nil || len(responseBody) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(responseBody, result)
+}
+
+// Largest page size accepted by Redash's paginated endpoints
+const apiMaxPageSize = 250
+
+// apiPaginatedList object structure for Redash's paginated list endpoints
+type apiPaginatedList[T any] struct {
+	Count    int `json:"count"`
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+	Results  []T `json:"results"`
+}
+
+// apiRequestAllPages fetches every page of a paginated Redash list endpoint
+func apiRequestAllPages[T any](c *redash.Client, path string, query url.Values) ([]T, error) {
+	results := []T{}
+
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+	pageQuery.Set("page_size", strconv.Itoa(apiMaxPageSize))
+
+	for page := 1; ; page++ {
+		pageQuery.Set("page", strconv.Itoa(page))
+
+		list := apiPaginatedList[T]{}
+		err := apiRequest(c, http.MethodGet, path, nil, pageQuery, &list)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, list.Results...)
+		if len(list.Results) == 0 || len(results) >= list.Count {
+			return results, nil
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// Object types which support object level permissions, mapped to their API paths
+var aclObjectTypes = map[string]string{
+	"query":     "queries",
+	"dashboard": "dashboards",
+}
+
+// Access types accepted by Redash's object permission endpoints
+var aclAccessTypes = []string{
+	"modify",
+	"view",
+	"delete",
+}
+
+// ObjectACLUser object structure for users listed in an object's access control list
+type ObjectACLUser struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// ObjectACL object structure from Redash's /api/<OBJECT_TYPE>/<ID>/acl endpoint,
+// keyed by access type
+type ObjectACL map[string][]ObjectACLUser
+
+// ObjectPermissionPayload defines the schema for granting/revoking an object permission
+type ObjectPermissionPayload struct {
+	AccessType string `json:"access_type"`
+	UserID     int    `json:"user_id"`
+}
+
+func aclPath(objectType string, objectID int) (string, error) {
+	objectPath, ok := aclObjectTypes[objectType]
+	if !ok {
+		return "", fmt.Errorf("Invalid object type: %s", objectType)
+	}
+
+	return fmt.Sprintf("/api/%s/%d/acl", objectPath, objectID), nil
+}
+
+// getObjectACL returns the access control list of a Redash query or dashboard
+func getObjectACL(c *redash.Client, objectType string, objectID int) (ObjectACL, error) {
+	path, err := aclPath(objectType, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	acl := ObjectACL{}
+	err = apiRequest(c, http.MethodGet, path, nil, nil, &acl)
+	if err != nil {
+		return nil, err
+	}
+
+	return acl, nil
+}
+
+// grantObjectPermission grants a user access to a Redash query or dashboard
+func grantObjectPermission(c *redash.Client, objectType string, objectID int, payload *ObjectPermissionPayload) error {
+	path, err := aclPath(objectType, objectID)
+	if err != nil {
+		return err
+	}
+
+	return apiRequest(c, http.MethodPost, path, payload, nil, nil)
+}
+
+// revokeObjectPermission revokes a user's access to a Redash query or dashboard
+func revokeObjectPermission(c *redash.Client, objectType string, objectID int, payload *ObjectPermissionPayload) error {
+	path, err := aclPath(objectType, objectID)
+	if err != nil {
+		return err
+	}
+
+	return apiRequest(c, http.MethodDelete, path, payload, nil, nil)
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"net/url"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// listDashboards returns every Redash dashboard carrying all of the given tags
+func listDashboards(c *redash.Client, tags []string) ([]redash.DashboardListItem, error) {
+	query := url.Values{}
+	for _, tag := range tags {
+		query.Add("tags", tag)
+	}
+
+	return apiRequestAllPages[redash.DashboardListItem](c, "/api/dashboards", query)
+}
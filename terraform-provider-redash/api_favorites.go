@@ -0,0 +1,18 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// setFavorite adds or removes a Redash object, such as /api/queries/1 or
+// /api/dashboards/my-dashboard, from the favorites of the API key's user
+func setFavorite(c *redash.Client, objectPath string, favorite bool) error {
+	method := http.MethodDelete
+	if favorite {
+		method = http.MethodPost
+	}
+
+	return apiRequest(c, method, objectPath+"/favorite", nil, nil, nil)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// GroupMember object structure from Redash's /api/groups/<ID>/members endpoint
+type GroupMember struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// getGroupMembers returns the users which are members of a Redash group
+func getGroupMembers(c *redash.Client, groupID int) ([]GroupMember, error) {
+	members := []GroupMember{}
+	err := apiRequest(c, http.MethodGet, "/api/groups/"+strconv.Itoa(groupID)+"/members", nil, nil, &members)
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// GroupDataSourceItem object structure from Redash's /api/groups/<ID>/data_sources endpoint
+type GroupDataSourceItem struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	ViewOnly bool   `json:"view_only"`
+}
+
+// GroupDataSourcePermissionPayload defines the schema for updating a group's access to a data source
+type GroupDataSourcePermissionPayload struct {
+	ViewOnly bool `json:"view_only"`
+}
+
+// getGroupDataSources returns the data sources a Redash group has access to
+func getGroupDataSources(c *redash.Client, groupID int) ([]GroupDataSourceItem, error) {
+	dataSources := []GroupDataSourceItem{}
+	err := apiRequest(c, http.MethodGet, "/api/groups/"+strconv.Itoa(groupID)+"/data_sources", nil, nil, &dataSources)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataSources, nil
+}
+
+// updateGroupDataSourcePermission sets whether a Redash group has view only access to a data source
+func updateGroupDataSourcePermission(c *redash.Client, groupID int, dataSourceID int, viewOnly bool) error {
+	path := "/api/groups/" + strconv.Itoa(groupID) + "/data_sources/" + strconv.Itoa(dataSourceID)
+
+	return apiRequest(c, http.MethodPost, path, &GroupDataSourcePermissionPayload{ViewOnly: viewOnly}, nil, nil)
+}
+
+// GroupUpdatePayload defines the schema for updating a Redash group. Stock
+// Redash ignores permissions sent to this endpoint, so they are not part of it
+type GroupUpdatePayload struct {
+	Name string `json:"name"`
+}
+
+// updateGroup updates an existing Redash group without re-sending its read only fields
+func updateGroup(c *redash.Client, id int, payload *GroupUpdatePayload) (*redash.Group, error) {
+	group := new(redash.Group)
+	err := apiRequest(c, http.MethodPost, "/api/groups/"+strconv.Itoa(id), payload, nil, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// listQueries returns every Redash query, or every archived query, carrying all of the given tags
+func listQueries(c *redash.Client, archived bool, tags []string) ([]redash.QueryListItem, error) {
+	path := "/api/queries"
+	if archived {
+		path = "/api/queries/archive"
+	}
+
+	query := url.Values{}
+	for _, tag := range tags {
+		query.Add("tags", tag)
+	}
+
+	return apiRequestAllPages[redash.QueryListItem](c, path, query)
+}
+
+// forkQuery creates a copy of a Redash query, including its visualizations
+func forkQuery(c *redash.Client, id int) (*redash.Query, error) {
+	query := redash.Query{}
+	err := apiRequest(c, http.MethodPost, fmt.Sprintf("/api/queries/%d/fork", id), nil, nil, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &query, nil
+}
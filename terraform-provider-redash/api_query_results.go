@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// QueryResultColumn object structure for the columns of a Redash query result
+type QueryResultColumn struct {
+	Name         string `json:"name"`
+	FriendlyName string `json:"friendly_name"`
+	Type         string `json:"type"`
+}
+
+// QueryResultData object structure for the data of a Redash query result
+type QueryResultData struct {
+	Columns []QueryResultColumn      `json:"columns"`
+	Rows    []map[string]interface{} `json:"rows"`
+}
+
+// QueryResult object structure for a Redash query result
+type QueryResult struct {
+	ID           int             `json:"id"`
+	QueryHash    string          `json:"query_hash"`
+	Query        string          `json:"query"`
+	Data         QueryResultData `json:"data"`
+	DataSourceID int             `json:"data_source_id"`
+	Runtime      float64         `json:"runtime"`
+	RetrievedAt  string          `json:"retrieved_at"`
+}
+
+// getQueryResult gets a specific Redash query result by its ID
+func getQueryResult(c *redash.Client, id int) (*QueryResult, error) {
+	response := struct {
+		QueryResult QueryResult `json:"query_result"`
+	}{}
+	err := apiRequest(c, http.MethodGet, fmt.Sprintf("/api/query_results/%d", id), nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.QueryResult, nil
+}
+
+// Redash query execution job statuses
+const (
+	jobStatusPending   = 1
+	jobStatusStarted   = 2
+	jobStatusSuccess   = 3
+	jobStatusFailure   = 4
+	jobStatusCancelled = 5
+)
+
+// Interval between two polls of a Redash query execution job
+const jobPollInterval = time.Second
+
+// Job object structure for a Redash query execution job
+type Job struct {
+	ID            string `json:"id"`
+	Status        int    `json:"status"`
+	Error         string `json:"error"`
+	QueryResultID *int   `json:"query_result_id"`
+}
+
+// QueryResultPayload defines the schema for requesting the result of a Redash
+// query. A max age of -1 accepts any cached result, 0 always executes the query
+type QueryResultPayload struct {
+	Parameters map[string]interface{} `json:"parameters"`
+	MaxAge     int                    `json:"max_age"`
+}
+
+// requestQueryResult requests the result of a Redash query. Redash either
+// returns a cached result or starts a job which executes the query
+func requestQueryResult(c *redash.Client, queryID int, payload *QueryResultPayload) (*QueryResult, *Job, error) {
+	response := struct {
+		QueryResult *QueryResult `json:"query_result"`
+		Job         *Job         `json:"job"`
+	}{}
+	err := apiRequest(c, http.MethodPost, fmt.Sprintf("/api/queries/%d/results", queryID), payload, nil, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.QueryResult, response.Job, nil
+}
+
+// getJob gets a specific Redash query execution job by its ID
+func getJob(c *redash.Client, id string) (*Job, error) {
+	response := struct {
+		Job Job `json:"job"`
+	}{}
+	err := apiRequest(c, http.MethodGet, "/api/jobs/"+id, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Job, nil
+}
+
+// waitForQueryResult requests the result of a Redash query and polls the job
+// executing it until it finishes or the context is done. A failed execution
+// returns the error reported by the data source
+func waitForQueryResult(ctx context.Context, c *redash.Client, queryID int, payload *QueryResultPayload) (*QueryResult, error) {
+	queryResult, job, err := requestQueryResult(c, queryID, payload)
+	if err != nil {
+		return nil, err
+	}
+	if queryResult != nil {
+		return queryResult, nil
+	}
+	if job == nil {
+		return nil, fmt.Errorf("no result or job returned for query %d", queryID)
+	}
+
+	for {
+		switch job.Status {
+		case jobStatusSuccess:
+			if job.QueryResultID == nil {
+				return nil, fmt.Errorf("job %s of query %d finished without a result", job.ID, queryID)
+			}
+			return getQueryResult(c, *job.QueryResultID)
+		case jobStatusFailure:
+			return nil, fmt.Errorf("query %d failed: %s", queryID, job.Error)
+		case jobStatusCancelled:
+			return nil, fmt.Errorf("query %d was cancelled", queryID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for job %s of query %d: %w", job.ID, queryID, ctx.Err())
+		case <-time.After(jobPollInterval):
+		}
+
+		job, err = getJob(c, job.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// QuerySnippet object structure from Redash's /api/query_snippets endpoint
+type QuerySnippet struct {
+	// Base Data
+	ID          int    `json:"id"`
+	Trigger     string `json:"trigger"`
+	Description string `json:"description"`
+	Snippet     string `json:"snippet"`
+
+	// User
+	User redash.User `json:"user"`
+
+	// Timestamps
+	UpdatedAt time.Time `json:"updated_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// QuerySnippetPayload defines the schema for creating/updating a Redash query snippet
+type QuerySnippetPayload struct {
+	Trigger     string `json:"trigger"`
+	Description string `json:"description"`
+	Snippet     string `json:"snippet"`
+}
+
+// getQuerySnippets returns all Redash query snippets
+func getQuerySnippets(c *redash.Client) ([]QuerySnippet, error) {
+	querySnippets := []QuerySnippet{}
+	err := apiRequest(c, http.MethodGet, "/api/query_snippets", nil, nil, &querySnippets)
+	if err != nil {
+		return nil, err
+	}
+
+	return querySnippets, nil
+}
+
+// getQuerySnippet returns a specific Redash query snippet by its ID
+func getQuerySnippet(c *redash.Client, id int) (*QuerySnippet, error) {
+	querySnippet := new(QuerySnippet)
+	err := apiRequest(c, http.MethodGet, "/api/query_snippets/"+strconv.Itoa(id), nil, nil, querySnippet)
+	if err != nil {
+		return nil, err
+	}
+
+	return querySnippet, nil
+}
+
+// getQuerySnippetByTrigger returns a specific Redash query snippet by its trigger
+func getQuerySnippetByTrigger(c *redash.Client, trigger string) (*QuerySnippet, error) {
+	querySnippets, err := getQuerySnippets(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, querySnippet := range querySnippets {
+		if querySnippet.Trigger == trigger {
+			return &querySnippet, nil
+		}
+	}
+
+	return nil, fmt.Errorf("No query snippet found with trigger: %s", trigger)
+}
+
+// createQuerySnippet creates a new Redash query snippet
+func createQuerySnippet(c *redash.Client, payload *QuerySnippetPayload) (*QuerySnippet, error) {
+	querySnippet := new(QuerySnippet)
+	err := apiRequest(c, http.MethodPost, "/api/query_snippets", payload, nil, querySnippet)
+	if err != nil {
+		return nil, err
+	}
+
+	return querySnippet, nil
+}
+
+// updateQuerySnippet updates an existing Redash query snippet
+func updateQuerySnippet(c *redash.Client, id int, payload *QuerySnippetPayload) (*QuerySnippet, error) {
+	querySnippet := new(QuerySnippet)
+	err := apiRequest(c, http.MethodPost, "/api/query_snippets/"+strconv.Itoa(id), payload, nil, querySnippet)
+	if err != nil {
+		return nil, err
+	}
+
+	return querySnippet, nil
+}
+
+// deleteQuerySnippet deletes a Redash query snippet
+func deleteQuerySnippet(c *redash.Client, id int) error {
+	return apiRequest(c, http.MethodDelete, "/api/query_snippets/"+strconv.Itoa(id), nil, nil, nil)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/samber/lo"
+)
+
+// UserInvitation object structure returned by Redash when creating or inviting a user
+type UserInvitation struct {
+	redash.User
+
+	// Only returned when Redash did not send the invitation by email
+	InviteLink string `json:"invite_link"`
+}
+
+// createUser creates a new Redash user, optionally without sending the invitation email
+func createUser(c *redash.Client, payload *redash.UserCreatePayload, sendInvite bool) (*UserInvitation, error) {
+	query := url.Values{}
+	if !sendInvite {
+		query.Add("no_invite", "yes")
+	}
+
+	user := new(UserInvitation)
+	err := apiRequest(c, http.MethodPost, "/api/users", payload, query, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// enableUser re-enables a disabled Redash user
+func enableUser(c *redash.Client, id int) error {
+	return apiRequest(c, http.MethodDelete, "/api/users/"+strconv.Itoa(id)+"/disable", nil, nil, nil)
+}
+
+// resendUserInvitation re-sends the invitation of a Redash user whose invitation is still pending
+func resendUserInvitation(c *redash.Client, id int) (*UserInvitation, error) {
+	user := new(UserInvitation)
+	err := apiRequest(c, http.MethodPost, "/api/users/"+strconv.Itoa(id)+"/invite", nil, nil, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// getAdminGroupID returns the ID of Redash's built-in admin group
+func getAdminGroupID(c *redash.Client) (int, error) {
+	groups, err := c.GetGroups()
+	if err != nil {
+		return 0, err
+	}
+
+	group, ok := lo.Find(*groups, func(group redash.Group) bool {
+		return group.Type == "builtin" && group.Name == "admin"
+	})
+	if !ok {
+		return 0, fmt.Errorf("No built-in admin group found")
+	}
+
+	return group.ID, nil
+}
+
+// getUserByName returns a single active user from their full name
+func getUserByName(c *redash.Client, name string) (*redash.User, error) {
+	results, err := c.SearchUsers(name)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []int{}
+	for _, result := range results.Results {
+		if result.Name == name {
+			matches = append(matches, result.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("No user found with name: %s", name)
+	case 1:
+		return c.GetUser(matches[0])
+	default:
+		return nil, fmt.Errorf("Multiple users found with name: %s (IDs: %v)", name, matches)
+	}
+}
+
+// UserListItem object structure for items of Redash's /api/users endpoint
+type UserListItem struct {
+	ID                  int                 `json:"id"`
+	Name                string              `json:"name"`
+	Email               string              `json:"email"`
+	AuthType            string              `json:"auth_type"`
+	IsDisabled          bool                `json:"is_disabled"`
+	IsInvitationPending bool                `json:"is_invitation_pending"`
+	Groups              []UserListItemGroup `json:"groups"`
+}
+
+// UserListItemGroup object structure for the groups of UserListItem
+type UserListItemGroup struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// listUsers returns every active or every disabled Redash user
+func listUsers(c *redash.Client, disabled bool) ([]UserListItem, error) {
+	query := url.Values{}
+	if disabled {
+		query.Add("disabled", "true")
+	}
+
+	return apiRequestAllPages[UserListItem](c, "/api/users", query)
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// Option structures for the visualization types which are not modelled by the
+// Redash client. Nullable column references are pointers so unset values are
+// sent as null, which is what the Redash UI does
+
+// COUNTER Options
+type CounterOptions struct {
+	CounterLabel      string `json:"counterLabel"`
+	CounterColName    string `json:"counterColName"`
+	RowNumber         int    `json:"rowNumber"`
+	TargetColName     string `json:"targetColName"`
+	TargetRowNumber   int    `json:"targetRowNumber"`
+	CountRow          bool   `json:"countRow"`
+	StringDecimal     int    `json:"stringDecimal"`
+	StringDecChar     string `json:"stringDecChar"`
+	StringThouSep     string `json:"stringThouSep"`
+	StringPrefix      string `json:"stringPrefix"`
+	StringSuffix      string `json:"stringSuffix"`
+	FormatTargetValue bool   `json:"formatTargetValue"`
+	TooltipFormat     string `json:"tooltipFormat"`
+}
+
+// PIVOT Options
+type PivotOptions struct {
+	Rows            []string             `json:"rows"`
+	Cols            []string             `json:"cols"`
+	Vals            []string             `json:"vals"`
+	AggregatorName  string               `json:"aggregatorName"`
+	RendererName    string               `json:"rendererName"`
+	Controls        PivotControls        `json:"controls"`
+	RendererOptions PivotRendererOptions `json:"rendererOptions"`
+}
+
+type PivotControls struct {
+	Enabled bool `json:"enabled"`
+}
+
+type PivotRendererOptions struct {
+	Table struct {
+		ColTotals bool `json:"colTotals"`
+		RowTotals bool `json:"rowTotals"`
+	} `json:"table"`
+}
+
+// DETAILS Options
+type DetailsOptions struct {
+	Columns []DetailsColumn `json:"columns"`
+}
+
+type DetailsColumn struct {
+	Name      string `json:"name"`
+	Title     string `json:"title"`
+	Visible   bool   `json:"visible"`
+	Order     int    `json:"order"`
+	DisplayAs string `json:"displayAs"`
+}
+
+// COHORT Options
+type CohortOptions struct {
+	TimeInterval        string `json:"timeInterval"`
+	Mode                string `json:"mode"`
+	DateColumn          string `json:"dateColumn"`
+	StageColumn         string `json:"stageColumn"`
+	TotalColumn         string `json:"totalColumn"`
+	ValueColumn         string `json:"valueColumn"`
+	NumberValuesFormat  string `json:"numberValuesFormat"`
+	PercentValuesFormat string `json:"percentValuesFormat"`
+	NoValuePlaceholder  string `json:"noValuePlaceholder"`
+	ShowTooltips        bool   `json:"showTooltips"`
+}
+
+// FUNNEL Options
+type FunnelOptions struct {
+	StepCol            FunnelColumn      `json:"stepCol"`
+	ValueCol           FunnelColumn      `json:"valueCol"`
+	AutoSort           bool              `json:"autoSort"`
+	SortKeyCol         FunnelSortColumn  `json:"sortKeyCol"`
+	ItemsLimit         int               `json:"itemsLimit"`
+	PercentValuesRange FunnelValuesRange `json:"percentValuesRange"`
+	NumberFormat       string            `json:"numberFormat"`
+	PercentFormat      string            `json:"percentFormat"`
+}
+
+type FunnelColumn struct {
+	ColName   *string `json:"colName"`
+	DisplayAs string  `json:"displayAs"`
+}
+
+type FunnelSortColumn struct {
+	ColName *string `json:"colName"`
+	Reverse bool    `json:"reverse"`
+}
+
+type FunnelValuesRange struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// WORD_CLOUD Options
+type WordCloudOptions struct {
+	Column            *string        `json:"column"`
+	FrequenciesColumn *string        `json:"frequenciesColumn"`
+	WordLengthLimit   WordCloudLimit `json:"wordLengthLimit"`
+	WordCountLimit    WordCloudLimit `json:"wordCountLimit"`
+}
+
+type WordCloudLimit struct {
+	Min *int `json:"min"`
+	Max *int `json:"max"`
+}
+
+// BOXPLOT Options
+type BoxplotOptions struct {
+	XAxisLabel string `json:"xAxisLabel"`
+	YAxisLabel string `json:"yAxisLabel"`
+}
+
+// MAP Options
+type MapOptions struct {
+	LatColName       string          `json:"latColName"`
+	LonColName       string          `json:"lonColName"`
+	Classify         *string         `json:"classify"`
+	MapTileUrl       string          `json:"mapTileUrl"`
+	ClusterMarkers   bool            `json:"clusterMarkers"`
+	CustomizeMarkers bool            `json:"customizeMarkers"`
+	IconShape        string          `json:"iconShape"`
+	IconFont         string          `json:"iconFont"`
+	ForegroundColor  string          `json:"foregroundColor"`
+	BackgroundColor  string          `json:"backgroundColor"`
+	BorderColor      string          `json:"borderColor"`
+	Tooltip          MapTemplateItem `json:"tooltip"`
+	Popup            MapTemplateItem `json:"popup"`
+}
+
+type MapTemplateItem struct {
+	Enabled  bool   `json:"enabled"`
+	Template string `json:"template"`
+}
+
+// CHOROPLETH Options
+type ChoroplethOptions struct {
+	MapType            string           `json:"mapType"`
+	KeyColumn          *string          `json:"keyColumn"`
+	TargetField        *string          `json:"targetField"`
+	ValueColumn        *string          `json:"valueColumn"`
+	ClusteringMode     string           `json:"clusteringMode"`
+	Steps              int              `json:"steps"`
+	ValueFormat        string           `json:"valueFormat"`
+	NoValuePlaceholder string           `json:"noValuePlaceholder"`
+	Colors             ChoroplethColors `json:"colors"`
+	Legend             ChoroplethLegend `json:"legend"`
+	Tooltip            MapTemplateItem  `json:"tooltip"`
+	Popup              MapTemplateItem  `json:"popup"`
+}
+
+type ChoroplethColors struct {
+	Min        string `json:"min"`
+	Max        string `json:"max"`
+	Background string `json:"background"`
+	Borders    string `json:"borders"`
+	NoValue    string `json:"noValue"`
+}
+
+type ChoroplethLegend struct {
+	Visible   bool   `json:"visible"`
+	Position  string `json:"position"`
+	AlignText string `json:"alignText"`
+}
+
+// CHART Options. The client library structure is missing several options
+// which can be set in the Redash UI, so the provider uses its own
+type ChartOptions struct {
+	// General
+	GlobalSeriesType    string             `json:"globalSeriesType"`
+	ColumnMapping       map[string]string  `json:"columnMapping"`
+	ErrorY              redash.ChartErrorY `json:"error_y"`
+	Legend              ChartLegend        `json:"legend"`
+	Series              ChartSeries        `json:"series"`
+	MissingValuesAsZero bool               `json:"missingValuesAsZero"`
+	// X-Axis
+	XAxis ChartXAxis `json:"xAxis"`
+	SortX bool       `json:"sortX"`
+	// Y-Axis
+	YAxis []ChartYAxis `json:"yAxis"`
+	// Series
+	SeriesOptions map[string]ChartSeriesOption `json:"seriesOptions"`
+	// Colors
+	ValuesOptions map[string]ChartValueOption `json:"valuesOptions"`
+	// Data Labels
+	ShowDataLabels bool   `json:"showDataLabels"`
+	NumberFormat   string `json:"numberFormat"`
+	PercentFormat  string `json:"percentFormat"`
+	DateTimeFormat string `json:"dateTimeFormat"`
+	TextFormat     string `json:"textFormat"`
+}
+
+type ChartLegend struct {
+	Enabled    bool   `json:"enabled"`
+	Placement  string `json:"placement"`
+	TraceOrder string `json:"traceorder"`
+}
+
+type ChartSeries struct {
+	Stacking *string            `json:"stacking"`
+	ErrorY   redash.ChartErrorY `json:"error_y"`
+}
+
+type ChartAxisTitle struct {
+	Text string `json:"text"`
+}
+
+type ChartXAxis struct {
+	Type   string         `json:"type"`
+	Title  ChartAxisTitle `json:"title"`
+	Labels struct {
+		Enabled bool `json:"enabled"`
+	} `json:"labels"`
+}
+
+type ChartYAxis struct {
+	Type     string         `json:"type"`
+	Title    ChartAxisTitle `json:"title"`
+	RangeMin *float64       `json:"rangeMin"`
+	RangeMax *float64       `json:"rangeMax"`
+	Opposite bool           `json:"opposite"`
+}
+
+type ChartSeriesOption struct {
+	Name   string `json:"name,omitempty"`
+	Color  string `json:"color,omitempty"`
+	ZIndex int    `json:"zIndex"`
+	Index  int    `json:"index"`
+	Type   string `json:"type"`
+	YAxis  int    `json:"yAxis"`
+}
+
+type ChartValueOption struct {
+	Color string `json:"color"`
+}
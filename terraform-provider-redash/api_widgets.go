@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+// WidgetPayload defines the schema for creating and updating a Redash widget.
+// Unlike the client library payloads, options can be any JSON object so that
+// options which are not modelled by the provider are preserved
+type WidgetPayload struct {
+	// Base Data
+	DashboardID int `json:"dashboard_id,omitempty"`
+
+	//
+	Text  string `json:"text"`
+	Width int    `json:"width"`
+
+	// References
+	VisualizationID *int `json:"visualization_id"`
+
+	// Options
+	Options interface{} `json:"options"`
+}
+
+// widgetRawOptions object structure for reading widget options as raw JSON
+type widgetRawOptions struct {
+	ID      int                    `json:"id"`
+	Options map[string]interface{} `json:"options"`
+}
+
+// dashboardWidgetsLocks holds a mutex per Redash client and dashboard slug
+var dashboardWidgetsLocks sync.Map
+
+type dashboardWidgetsKey struct {
+	client *redash.Client
+	slug   string
+}
+
+// lockDashboardWidgets serialises the creation of widgets on a dashboard, as
+// Terraform creates sibling widgets in parallel and each one is placed from
+// the layout of the dashboard. It returns the function releasing the lock
+func lockDashboardWidgets(c *redash.Client, dashboardSlug string) func() {
+	lock, _ := dashboardWidgetsLocks.LoadOrStore(dashboardWidgetsKey{client: c, slug: dashboardSlug}, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+
+	return lock.(*sync.Mutex).Unlock
+}
+
+// createWidget creates a new Redash widget
+func createWidget(c *redash.Client, payload *WidgetPayload) (*redash.WidgetDashboard, error) {
+	widget := redash.WidgetDashboard{}
+	err := apiRequest(c, http.MethodPost, "/api/widgets", payload, nil, &widget)
+	if err != nil {
+		return nil, err
+	}
+
+	return &widget, nil
+}
+
+// updateWidget updates an existing Redash widget
+func updateWidget(c *redash.Client, id int, payload *WidgetPayload) (*redash.WidgetDashboard, error) {
+	widget := redash.WidgetDashboard{}
+	err := apiRequest(c, http.MethodPost, fmt.Sprintf("/api/widgets/%d", id), payload, nil, &widget)
+	if err != nil {
+		return nil, err
+	}
+
+	return &widget, nil
+}
+
+// getWidgetOptions returns the options of a widget as untyped JSON, including
+// options which are not modelled by the client library
+func getWidgetOptions(c *redash.Client, dashboardSlug string, id int) (map[string]interface{}, error) {
+	dashboard := struct {
+		Widgets []widgetRawOptions `json:"widgets"`
+	}{}
+	err := apiRequest(c, http.MethodGet, "/api/dashboards/"+dashboardSlug, nil, nil, &dashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, widget := range dashboard.Widgets {
+		if widget.ID == id {
+			return widget.Options, nil
+		}
+	}
+
+	return nil, fmt.Errorf("widget %d not found in dashboard %s", id, dashboardSlug)
+}
@@ -2,26 +2,126 @@ package main
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
 )
 
 func dataSourceRedashDashboard() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			// Lookup
 			"slug": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"slug", "id", "name"},
 			},
 			"id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"slug", "id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"slug", "id", "name"},
+			},
+			// State
+			"is_archived": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_draft": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dashboard_filters_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"name": {
+			// Metadata
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Dashboard Specific
+			"public_url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"widgets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"width": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"visualization_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"query_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_hidden": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"position": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"col": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"row": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"size_x": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"size_y": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"auto_height": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		ReadContext: dataSourceRedashDashboardRead,
 	}
@@ -32,16 +132,87 @@ func dataSourceRedashDashboardRead(_ context.Context, d *schema.ResourceData, me
 
 	var diags diag.Diagnostics
 
-	slug := d.Get("slug").(string)
+	slug, ok := d.GetOk("slug")
+	if !ok {
+		dashboardSlug, err := dataSourceRedashDashboardFind(d, c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		slug = dashboardSlug
+	}
 
-	dashboard, err := c.GetDashboard(slug)
+	dashboard, err := c.GetDashboard(slug.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(dashboard.Slug)
+	// Base Data
 	_ = d.Set("id", dashboard.ID)
 	_ = d.Set("name", dashboard.Name)
+	_ = d.Set("slug", dashboard.Slug)
+	// State
+	_ = d.Set("is_archived", dashboard.IsArchived)
+	_ = d.Set("is_draft", dashboard.IsDraft)
+	_ = d.Set("dashboard_filters_enabled", dashboard.DashboardFiltersEnabled)
+	_ = d.Set("version", dashboard.Version)
+	// Metadata
+	_ = d.Set("tags", dashboard.Tags)
+	// Dashboard Specific
+	_ = d.Set("public_url", dashboard.PublicUrl)
+	_ = d.Set("widgets", lo.Map(dashboard.Widgets, func(widget redash.WidgetDashboard, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":               widget.ID,
+			"text":             widget.Text,
+			"width":            widget.Width,
+			"visualization_id": widget.Visualization.ID,
+			"query_id":         widget.Visualization.Query.ID,
+			"is_hidden":        widget.Options.IsHidden,
+			"position": []map[string]interface{}{{
+				"col":         widget.Options.Position.Col,
+				"row":         widget.Options.Position.Row,
+				"size_x":      widget.Options.Position.SizeX,
+				"size_y":      widget.Options.Position.SizeY,
+				"auto_height": widget.Options.Position.AutoHeight,
+			}},
+		}
+	}))
 
 	return diags
 }
+
+// dataSourceRedashDashboardFind returns the slug of the single dashboard
+// matching the configured ID or name
+func dataSourceRedashDashboardFind(d *schema.ResourceData, c *redash.Client) (string, error) {
+	dashboards, err := listDashboards(c, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var description string
+	if id, ok := d.GetOk("id"); ok {
+		description = fmt.Sprintf("ID %d", id)
+		dashboards = lo.Filter(dashboards, func(dashboard redash.DashboardListItem, _ int) bool {
+			return dashboard.ID == id.(int)
+		})
+	} else {
+		name := d.Get("name").(string)
+		description = fmt.Sprintf("name %q", name)
+		dashboards = lo.Filter(dashboards, func(dashboard redash.DashboardListItem, _ int) bool {
+			return dashboard.Name == name
+		})
+	}
+
+	switch len(dashboards) {
+	case 0:
+		return "", fmt.Errorf("No dashboard found with %s", description)
+	case 1:
+		return dashboards[0].Slug, nil
+	default:
+		return "", fmt.Errorf(
+			"Multiple dashboards found with %s (slugs: %v), please look it up by slug instead",
+			description,
+			lo.Map(dashboards, func(dashboard redash.DashboardListItem, _ int) string { return dashboard.Slug }),
+		)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func dataSourceRedashDashboards() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			// Results
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"dashboards": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"is_draft": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceRedashDashboardsRead,
+	}
+}
+
+func dataSourceRedashDashboardsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	tags := lo.Map(d.Get("tags").([]interface{}), func(item interface{}, _ int) string {
+		return item.(string)
+	})
+
+	dashboards, err := listDashboards(c, tags)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(nameRegex.(string))
+		dashboards = lo.Filter(dashboards, func(dashboard redash.DashboardListItem, _ int) bool {
+			return re.MatchString(dashboard.Name)
+		})
+	}
+
+	ids := lo.Map(dashboards, func(dashboard redash.DashboardListItem, _ int) int {
+		return dashboard.ID
+	})
+
+	d.SetId(fmt.Sprint(schema.HashString(fmt.Sprint(ids))))
+	_ = d.Set("ids", ids)
+	_ = d.Set("dashboards", lo.Map(dashboards, func(dashboard redash.DashboardListItem, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       dashboard.ID,
+			"name":     dashboard.Name,
+			"slug":     dashboard.Slug,
+			"tags":     dashboard.Tags,
+			"is_draft": dashboard.IsDraft,
+			"user_id":  dashboard.UserID,
+		}
+	}))
+
+	return diags
+}
@@ -13,22 +13,35 @@ package main
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 )
 
 func dataSourceRedashDataSource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "name_regex"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "name_regex"},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				ExactlyOneOf: []string{"id", "name", "name_regex"},
 			},
 			"scheduled_queue_name": {
 				Type:     schema.TypeString,
@@ -48,6 +61,7 @@ func dataSourceRedashDataSource() *schema.Resource {
 			},
 			"type": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"syntax": {
@@ -68,9 +82,16 @@ func dataSourceRedashDataSourceRead(_ context.Context, d *schema.ResourceData, m
 
 	var diags diag.Diagnostics
 
-	id := d.Get("id").(int)
+	id, ok := d.GetOk("id")
+	if !ok {
+		dataSourceID, err := dataSourceRedashDataSourceFind(d, c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		id = dataSourceID
+	}
 
-	dataSource, err := c.GetDataSource(id)
+	dataSource, err := c.GetDataSource(id.(int))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,3 +108,47 @@ func dataSourceRedashDataSourceRead(_ context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// dataSourceRedashDataSourceFind returns the ID of the single data source matching
+// the configured name or name regex, and type when given
+func dataSourceRedashDataSourceFind(d *schema.ResourceData, c *redash.Client) (int, error) {
+	dataSources, err := c.GetDataSources()
+	if err != nil {
+		return 0, err
+	}
+
+	var description string
+	var matchName func(name string) bool
+	if name, ok := d.GetOk("name"); ok {
+		description = fmt.Sprintf("name %q", name)
+		matchName = func(dataSourceName string) bool {
+			return dataSourceName == name.(string)
+		}
+	} else {
+		nameRegex := d.Get("name_regex").(string)
+		description = fmt.Sprintf("name matching %q", nameRegex)
+		matchName = regexp.MustCompile(nameRegex).MatchString
+	}
+
+	dataSourceType, filterType := d.GetOk("type")
+	if filterType {
+		description += fmt.Sprintf(" and type %q", dataSourceType)
+	}
+
+	matches := lo.Filter(*dataSources, func(dataSource redash.DataSource, _ int) bool {
+		return matchName(dataSource.Name) && (!filterType || dataSource.Type == dataSourceType.(string))
+	})
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("No data source found with %s", description)
+	case 1:
+		return matches[0].ID, nil
+	default:
+		return 0, fmt.Errorf(
+			"Multiple data sources found with %s (IDs: %v), please use a more specific filter",
+			description,
+			lo.Map(matches, func(dataSource redash.DataSource, _ int) int { return dataSource.ID }),
+		)
+	}
+}
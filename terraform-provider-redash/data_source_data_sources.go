@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func dataSourceRedashDataSources() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			// Results
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"data_sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"syntax": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paused": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pause_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceRedashDataSourcesRead,
+	}
+}
+
+func dataSourceRedashDataSourcesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	allDataSources, err := c.GetDataSources()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSources := *allDataSources
+	if dataSourceType, ok := d.GetOk("type"); ok {
+		dataSources = lo.Filter(dataSources, func(dataSource redash.DataSource, _ int) bool {
+			return dataSource.Type == dataSourceType.(string)
+		})
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(nameRegex.(string))
+		dataSources = lo.Filter(dataSources, func(dataSource redash.DataSource, _ int) bool {
+			return re.MatchString(dataSource.Name)
+		})
+	}
+
+	ids := lo.Map(dataSources, func(dataSource redash.DataSource, _ int) int {
+		return dataSource.ID
+	})
+
+	d.SetId(fmt.Sprint(schema.HashString(fmt.Sprint(ids))))
+	_ = d.Set("ids", ids)
+	_ = d.Set("data_sources", lo.Map(dataSources, func(dataSource redash.DataSource, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":           dataSource.ID,
+			"name":         dataSource.Name,
+			"type":         dataSource.Type,
+			"syntax":       dataSource.Syntax,
+			"paused":       dataSource.Paused,
+			"pause_reason": dataSource.PauseReason,
+		}
+	}))
+
+	return diags
+}
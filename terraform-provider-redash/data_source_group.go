@@ -17,6 +17,7 @@ import (
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
 )
 
 func dataSourceRedashGroup() *schema.Resource {
@@ -30,6 +31,26 @@ func dataSourceRedashGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 		ReadContext: dataSourceRedashGroupRead,
 	}
@@ -46,8 +67,20 @@ func dataSourceRedashGroupRead(_ context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
+	members, err := getGroupMembers(c, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(fmt.Sprint(group.ID))
 	_ = d.Set("name", group.Name)
+	_ = d.Set("members", lo.Map(members, func(member GroupMember, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":    member.ID,
+			"name":  member.Name,
+			"email": member.Email,
+		}
+	}))
 
 	return diags
 }
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func dataSourceRedashGroups() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"builtin", "regular"}, false),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			// Results
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceRedashGroupsRead,
+	}
+}
+
+func dataSourceRedashGroupsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	allGroups, err := c.GetGroups()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groups := *allGroups
+	if groupType, ok := d.GetOk("type"); ok {
+		groups = lo.Filter(groups, func(group redash.Group, _ int) bool {
+			return group.Type == groupType.(string)
+		})
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(nameRegex.(string))
+		groups = lo.Filter(groups, func(group redash.Group, _ int) bool {
+			return re.MatchString(group.Name)
+		})
+	}
+
+	ids := lo.Map(groups, func(group redash.Group, _ int) int {
+		return group.ID
+	})
+
+	d.SetId(fmt.Sprint(schema.HashString(fmt.Sprint(ids))))
+	_ = d.Set("ids", ids)
+	_ = d.Set("groups", lo.Map(groups, func(group redash.Group, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":          group.ID,
+			"name":        group.Name,
+			"type":        group.Type,
+			"permissions": group.Permissions,
+		}
+	}))
+
+	return diags
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func dataSourceRedashQueries() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"data_source_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"is_archived": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Results
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"queries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_source_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"is_archived": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_draft": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceRedashQueriesRead,
+	}
+}
+
+func dataSourceRedashQueriesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	tags := lo.Map(d.Get("tags").([]interface{}), func(item interface{}, _ int) string {
+		return item.(string)
+	})
+
+	queries, err := listQueries(c, d.Get("is_archived").(bool), tags)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if dataSourceID, ok := d.GetOk("data_source_id"); ok {
+		queries = lo.Filter(queries, func(query redash.QueryListItem, _ int) bool {
+			return query.DataSourceID == dataSourceID.(int)
+		})
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(nameRegex.(string))
+		queries = lo.Filter(queries, func(query redash.QueryListItem, _ int) bool {
+			return re.MatchString(query.Name)
+		})
+	}
+
+	ids := lo.Map(queries, func(query redash.QueryListItem, _ int) int {
+		return query.ID
+	})
+
+	d.SetId(fmt.Sprint(schema.HashString(fmt.Sprint(ids))))
+	_ = d.Set("ids", ids)
+	_ = d.Set("queries", lo.Map(queries, func(query redash.QueryListItem, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":             query.ID,
+			"name":           query.Name,
+			"description":    query.Description,
+			"data_source_id": query.DataSourceID,
+			"tags":           query.Tags,
+			"is_archived":    query.IsArchived,
+			"is_draft":       query.IsDraft,
+			"user_id":        query.User.ID,
+		}
+	}))
+
+	return diags
+}
@@ -2,24 +2,41 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
 )
 
 func dataSourceRedashQuery() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			// Lookup
 			"id": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"id", "name", "tag"},
+				ConflictsWith: []string{"name", "tag"},
 			},
 			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Base Data
+			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			// Query
 			"query": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -28,10 +45,143 @@ func dataSourceRedashQuery() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"description": {
+			"query_hash": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			// Options
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enum_options": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_query_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"global": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// State
+			"is_draft": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_archived": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// User
+			"owner": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// Metadata
+			"api_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"day_of_week": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"until": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// Query Specific
+			"visualizations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 		ReadContext: dataSourceRedashQueryRead,
 	}
@@ -42,17 +192,135 @@ func dataSourceRedashQueryRead(_ context.Context, d *schema.ResourceData, meta i
 
 	var diags diag.Diagnostics
 
-	id := d.Get("id").(int)
-	query, err := c.GetQuery(id)
+	id, ok := d.GetOk("id")
+	if !ok {
+		queryID, err := dataSourceRedashQueryFind(d, c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		id = queryID
+	}
+
+	query, err := c.GetQuery(id.(int))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	parameters := make([]map[string]interface{}, len(query.Options.Parameters))
+	for i, parameter := range query.Options.Parameters {
+		value, err := queryParameterValueString(parameter.Value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		parameters[i] = map[string]interface{}{
+			"name":            parameter.Name,
+			"title":           parameter.Title,
+			"type":            parameter.Type,
+			"value":           value,
+			"enum_options":    parameter.EnumOptions,
+			"parent_query_id": parameter.ParentQueryId,
+			"global":          parameter.Global,
+		}
+	}
+
+	schedule := []map[string]interface{}{}
+	if query.Schedule.Interval != 0 {
+		schedule = append(schedule, map[string]interface{}{
+			"interval":    query.Schedule.Interval,
+			"time":        query.Schedule.Time,
+			"day_of_week": query.Schedule.DayOfWeek,
+			"until":       lo.Ternary(query.Schedule.Until == nil, "", fmt.Sprint(query.Schedule.Until)),
+		})
+	}
+
 	d.SetId(fmt.Sprint(query.ID))
+	// Base Data
+	_ = d.Set("id", query.ID)
 	_ = d.Set("name", query.Name)
+	_ = d.Set("description", query.Description)
+	// Query
 	_ = d.Set("query", query.Query)
 	_ = d.Set("data_source_id", query.DataSourceID)
-	_ = d.Set("description", query.Description)
+	_ = d.Set("query_hash", query.QueryHash)
+	// Options
+	_ = d.Set("parameters", parameters)
+	// State
+	_ = d.Set("is_draft", query.IsDraft)
+	_ = d.Set("is_archived", query.IsArchived)
+	_ = d.Set("version", query.Version)
+	// User
+	_ = d.Set("owner", []map[string]interface{}{{
+		"id":    query.User.ID,
+		"name":  query.User.Name,
+		"email": query.User.Email,
+	}})
+	// Metadata
+	_ = d.Set("api_key", query.APIKey)
+	_ = d.Set("tags", query.Tags)
+	_ = d.Set("schedule", schedule)
+	// Query Specific
+	_ = d.Set("visualizations", lo.Map(query.Visualizations, func(visualization redash.VisualizationQuery, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":          visualization.ID,
+			"name":        visualization.Name,
+			"type":        visualization.Type,
+			"description": visualization.Description,
+		}
+	}))
 
 	return diags
 }
+
+// dataSourceRedashQueryFind returns the ID of the single query matching the
+// configured name and/or tag
+func dataSourceRedashQueryFind(d *schema.ResourceData, c *redash.Client) (int, error) {
+	var tags []string
+	var description string
+	if tag, ok := d.GetOk("tag"); ok {
+		tags = append(tags, tag.(string))
+		description = fmt.Sprintf("tag %q", tag)
+	}
+
+	queries, err := listQueries(c, false, tags)
+	if err != nil {
+		return 0, err
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		queries = lo.Filter(queries, func(query redash.QueryListItem, _ int) bool {
+			return query.Name == name.(string)
+		})
+		description = lo.Ternary(description == "", "", description+" and ") + fmt.Sprintf("name %q", name)
+	}
+
+	switch len(queries) {
+	case 0:
+		return 0, fmt.Errorf("No query found with %s", description)
+	case 1:
+		return queries[0].ID, nil
+	default:
+		return 0, fmt.Errorf(
+			"Multiple queries found with %s (IDs: %v), please use a more specific filter",
+			description,
+			lo.Map(queries, func(query redash.QueryListItem, _ int) int { return query.ID }),
+		)
+	}
+}
+
+// queryParameterValueString returns a query parameter value as a string, JSON
+// encoding values which are not plain strings such as numbers, lists and ranges
+func queryParameterValueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func dataSourceRedashQueryResult() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Lookup
+			"query_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_age": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			// Result
+			"query_result_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"retrieved_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runtime": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		ReadContext: dataSourceRedashQueryResultRead,
+	}
+}
+
+func dataSourceRedashQueryResultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	queryID := d.Get("query_id").(int)
+	queryResult, err := waitForQueryResult(ctx, c, queryID, &QueryResultPayload{
+		Parameters: d.Get("parameters").(map[string]interface{}),
+		MaxAge:     d.Get("max_age").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rows, err := queryResultFlattenRows(queryResult.Data.Rows)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(queryResult.ID))
+	// Result
+	_ = d.Set("query_result_id", queryResult.ID)
+	_ = d.Set("retrieved_at", queryResult.RetrievedAt)
+	_ = d.Set("runtime", queryResult.Runtime)
+	_ = d.Set("columns", lo.Map(queryResult.Data.Columns, func(column QueryResultColumn, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"name":          column.Name,
+			"friendly_name": column.FriendlyName,
+			"type":          column.Type,
+		}
+	}))
+	_ = d.Set("rows", rows)
+
+	return diags
+}
+
+// queryResultFlattenRows converts query result rows into maps of strings, as
+// Terraform maps hold values of a single type. Strings are kept as is, other
+// values are encoded as JSON and null values are left out, so that they can be
+// told apart from empty strings
+func queryResultFlattenRows(rows []map[string]interface{}) ([]map[string]interface{}, error) {
+	flattened := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		flattenedRow := map[string]interface{}{}
+		for column, value := range row {
+			switch value := value.(type) {
+			case nil:
+				continue
+			case string:
+				flattenedRow[column] = value
+			default:
+				rawValue, err := json.Marshal(value)
+				if err != nil {
+					return nil, err
+				}
+				flattenedRow[column] = string(rawValue)
+			}
+		}
+		flattened = append(flattened, flattenedRow)
+	}
+
+	return flattened, nil
+}
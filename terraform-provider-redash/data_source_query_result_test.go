@@ -0,0 +1,51 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueryResultFlattenRows(t *testing.T) {
+	rows, err := queryResultFlattenRows([]map[string]interface{}{
+		{
+			"name":    "acme",
+			"empty":   "",
+			"missing": nil,
+			"count":   float64(42),
+			"ratio":   0.5,
+			"active":  true,
+			"tags":    []interface{}{"a", "b"},
+			"meta":    map[string]interface{}{"plan": "pro"},
+		},
+		{},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"name":   "acme",
+			"empty":  "",
+			"count":  "42",
+			"ratio":  "0.5",
+			"active": "true",
+			"tags":   `["a","b"]`,
+			"meta":   `{"plan":"pro"}`,
+		},
+		{},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %v, got %v", expected, rows)
+	}
+}
+
+func TestQueryResultFlattenRowsEmpty(t *testing.T) {
+	rows, err := queryResultFlattenRows(nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if rows == nil || len(rows) != 0 {
+		t.Errorf("expected an empty list of rows, got %v", rows)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRedashQuerySnippet() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"trigger": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snippet": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		ReadContext: dataSourceRedashQuerySnippetRead,
+	}
+}
+
+func dataSourceRedashQuerySnippetRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	trigger := d.Get("trigger").(string)
+	querySnippet, err := getQuerySnippetByTrigger(c, trigger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(querySnippet.ID))
+	_ = d.Set("id", querySnippet.ID)
+	_ = d.Set("description", querySnippet.Description)
+	_ = d.Set("snippet", querySnippet.Snippet)
+
+	return diags
+}
@@ -13,22 +13,77 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
 )
 
 func dataSourceRedashUser() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "email", "name"},
 			},
 			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "email", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "email", "name"},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"profile_image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_invitation_pending": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_email_verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"active_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
+			},
+			"disabled_at": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 		ReadContext: dataSourceRedashUserRead,
@@ -40,14 +95,33 @@ func dataSourceRedashUserRead(_ context.Context, d *schema.ResourceData, meta in
 
 	var diags diag.Diagnostics
 
-	email := d.Get("email").(string)
-	user, err := c.GetUserByEmail(email)
+	var user *redash.User
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		user, err = c.GetUser(id.(int))
+	} else if email, ok := d.GetOk("email"); ok {
+		user, err = c.GetUserByEmail(email.(string))
+	} else {
+		user, err = getUserByName(c, d.Get("name").(string))
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprint(user.ID))
+	_ = d.Set("id", user.ID)
+	_ = d.Set("email", user.Email)
 	_ = d.Set("name", user.Name)
+	_ = d.Set("groups", user.Groups)
+	_ = d.Set("auth_type", user.AuthType)
+	_ = d.Set("is_disabled", user.IsDisabled)
+	_ = d.Set("profile_image_url", user.ProfileImageURL)
+	_ = d.Set("is_invitation_pending", user.IsInvitationPending)
+	_ = d.Set("is_email_verified", user.IsEmailVerified)
+	_ = d.Set("active_at", lo.Ternary(user.ActiveAt.IsZero(), "", user.ActiveAt.Format(time.RFC3339)))
+	_ = d.Set("created_at", user.CreatedAt.Format(time.RFC3339))
+	_ = d.Set("updated_at", user.UpdatedAt.Format(time.RFC3339))
+	_ = d.Set("disabled_at", lo.Ternary(user.DisabledAt == nil, "", fmt.Sprint(user.DisabledAt)))
 
 	return diags
 }
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func dataSourceRedashUsers() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"email_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			// Results
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_invitation_pending": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceRedashUsersRead,
+	}
+}
+
+func dataSourceRedashUsersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	// Redash lists active and disabled users separately
+	users := []UserListItem{}
+	rawDisabled := d.GetRawConfig().GetAttr("is_disabled")
+	for _, disabled := range []bool{false, true} {
+		if !rawDisabled.IsNull() && rawDisabled.True() != disabled {
+			continue
+		}
+
+		list, err := listUsers(c, disabled)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		users = append(users, list...)
+	}
+
+	if groupID, ok := d.GetOk("group_id"); ok {
+		users = lo.Filter(users, func(user UserListItem, _ int) bool {
+			return lo.ContainsBy(user.Groups, func(group UserListItemGroup) bool {
+				return group.ID == groupID.(int)
+			})
+		})
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(nameRegex.(string))
+		users = lo.Filter(users, func(user UserListItem, _ int) bool {
+			return re.MatchString(user.Name)
+		})
+	}
+	if emailRegex, ok := d.GetOk("email_regex"); ok {
+		re := regexp.MustCompile(emailRegex.(string))
+		users = lo.Filter(users, func(user UserListItem, _ int) bool {
+			return re.MatchString(user.Email)
+		})
+	}
+
+	ids := lo.Map(users, func(user UserListItem, _ int) int {
+		return user.ID
+	})
+
+	d.SetId(fmt.Sprint(schema.HashString(fmt.Sprint(ids))))
+	_ = d.Set("ids", ids)
+	_ = d.Set("users", lo.Map(users, func(user UserListItem, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                    user.ID,
+			"name":                  user.Name,
+			"email":                 user.Email,
+			"auth_type":             user.AuthType,
+			"is_disabled":           user.IsDisabled,
+			"is_invitation_pending": user.IsInvitationPending,
+			"groups": lo.Map(user.Groups, func(group UserListItemGroup, _ int) int {
+				return group.ID
+			}),
+		}
+	}))
+
+	return diags
+}
@@ -2,28 +2,58 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
 )
 
 func dataSourceRedashVisualization() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"query_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-			},
-			"visualization_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+	dataSourceSchema := map[string]*schema.Schema{
+		// Lookup
+		"query_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"visualization_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"visualization_id", "name"},
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"visualization_id", "name"},
+		},
+		// Base Data
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		// Options
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"options_json": {
+			Type:     schema.TypeString,
+			Computed: true,
 		},
+	}
+
+	// Options (By Type)
+	visualizationSchema := resourceRedashVisualization().Schema
+	for _, key := range visualizationOptionsKeys {
+		dataSourceSchema[key] = dataSourceSchemaFromResourceSchema(visualizationSchema[key])
+	}
+
+	return &schema.Resource{
+		Schema:      dataSourceSchema,
 		ReadContext: dataSourceRedashVisualizationRead,
 	}
 }
@@ -34,15 +64,91 @@ func dataSourceRedashVisualizationRead(_ context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	queryID := d.Get("query_id").(int)
-	visualizationID := d.Get("visualization_id").(int)
+	query, err := c.GetQuery(queryID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var description string
+	visualizations := query.Visualizations
+	if visualizationID, ok := d.GetOk("visualization_id"); ok {
+		description = fmt.Sprintf("ID %d", visualizationID)
+		visualizations = lo.Filter(visualizations, func(visualization redash.VisualizationQuery, _ int) bool {
+			return visualization.ID == visualizationID.(int)
+		})
+	} else {
+		name := d.Get("name").(string)
+		description = fmt.Sprintf("name %q", name)
+		visualizations = lo.Filter(visualizations, func(visualization redash.VisualizationQuery, _ int) bool {
+			return visualization.Name == name
+		})
+	}
+
+	switch len(visualizations) {
+	case 0:
+		return diag.Errorf("No visualization found with %s in query %d", description, queryID)
+	case 1:
+		break
+	default:
+		return diag.Errorf(
+			"Multiple visualizations found with %s in query %d (IDs: %v), please look it up by ID instead",
+			description,
+			queryID,
+			lo.Map(visualizations, func(visualization redash.VisualizationQuery, _ int) int { return visualization.ID }),
+		)
+	}
+	visualization := visualizations[0]
+
+	var options []map[string]interface{}
+	if _, ok := visualizationOptionsKeys[visualization.Type]; ok {
+		options, err = visualizationFlattenOptions(visualization.Type, visualization.Options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	visualization, err := c.GetVisualization(queryID, visualizationID)
+	optionsJSON, err := json.Marshal(visualization.Options)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprint(visualization.ID))
+	// Base Data
+	_ = d.Set("visualization_id", visualization.ID)
 	_ = d.Set("name", visualization.Name)
+	_ = d.Set("description", visualization.Description)
+	// Options
+	_ = d.Set("type", visualization.Type)
+	_ = d.Set("options_json", string(optionsJSON))
+	for vType, key := range visualizationOptionsKeys {
+		_ = d.Set(key, lo.Ternary(vType == visualization.Type, options, []map[string]interface{}{}))
+	}
 
 	return diags
 }
+
+// dataSourceSchemaFromResourceSchema returns a copy of a resource attribute
+// schema, including any nested blocks, with every attribute marked as computed
+// so the same structure can be exported by a data source
+func dataSourceSchemaFromResourceSchema(resourceSchema *schema.Schema) *schema.Schema {
+	dataSourceSchema := &schema.Schema{
+		Type:      resourceSchema.Type,
+		Computed:  true,
+		Sensitive: resourceSchema.Sensitive,
+	}
+
+	switch elem := resourceSchema.Elem.(type) {
+	case *schema.Resource:
+		dataSourceSchema.Elem = &schema.Resource{
+			Schema: lo.MapValues(elem.Schema, func(nested *schema.Schema, _ string) *schema.Schema {
+				return dataSourceSchemaFromResourceSchema(nested)
+			}),
+		}
+	case *schema.Schema:
+		dataSourceSchema.Elem = &schema.Schema{
+			Type: elem.Type,
+		}
+	}
+
+	return dataSourceSchema
+}
@@ -2,15 +2,20 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceRedashWidget() *schema.Resource {
+	widgetSchema := resourceRedashWidget().Schema
+
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			// Base Data
 			"widget_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -23,6 +28,30 @@ func dataSourceRedashWidget() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			//
+			"text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"width": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// References
+			"visualization_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"query_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// Options
+			"options_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"options": dataSourceSchemaFromResourceSchema(widgetSchema["options"]),
 		},
 		ReadContext: dataSourceRedashWidgetRead,
 	}
@@ -38,9 +67,29 @@ func dataSourceRedashWidgetRead(_ context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	options, err := getWidgetOptions(c, d.Get("dashboard_slug").(string), widget.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(fmt.Sprint(widget.ID))
+	// Base Data
 	_ = d.Set("dashboard_slug", d.Get("dashboard_slug"))
 	_ = d.Set("dashboard_id", widget.DashboardID)
+	//
+	_ = d.Set("text", widget.Text)
+	_ = d.Set("width", widget.Width)
+	// References
+	_ = d.Set("visualization_id", widget.Visualization.ID)
+	_ = d.Set("query_id", widget.Visualization.Query.ID)
+	// Options
+	_ = d.Set("options_json", string(optionsJSON))
+	_ = d.Set("options", widgetFlattenOptions(widget.Options))
 
 	return diags
 }
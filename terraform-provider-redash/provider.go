@@ -41,19 +41,34 @@ func Provider() *schema.Provider {
 			"redash_user":          dataSourceRedashUser(),
 			"redash_group":         dataSourceRedashGroup(),
 			"redash_query":         dataSourceRedashQuery(),
+			"redash_query_result":  dataSourceRedashQueryResult(),
 			"redash_dashboard":     dataSourceRedashDashboard(),
 			"redash_widget":        dataSourceRedashWidget(),
 			"redash_visualization": dataSourceRedashVisualization(),
+			"redash_query_snippet": dataSourceRedashQuerySnippet(),
+			"redash_data_sources":  dataSourceRedashDataSources(),
+			"redash_users":         dataSourceRedashUsers(),
+			"redash_groups":        dataSourceRedashGroups(),
+			"redash_queries":       dataSourceRedashQueries(),
+			"redash_dashboards":    dataSourceRedashDashboards(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"redash_data_source":                  resourceRedashDataSource(),
 			"redash_user":                         resourceRedashUser(),
 			"redash_group":                        resourceRedashGroup(),
 			"redash_group_data_source_attachment": resourceRedashGroupDataSourceAttachment(),
+			"redash_group_data_sources":           resourceRedashGroupDataSources(),
+			"redash_group_member":                 resourceRedashGroupMember(),
+			"redash_group_members":                resourceRedashGroupMembers(),
 			"redash_query":                        resourceRedashQuery(),
+			"redash_query_clone":                  resourceRedashQueryClone(),
 			"redash_dashboard":                    resourceRedashDashboard(),
 			"redash_widget":                       resourceRedashWidget(),
+			"redash_text_widget":                  resourceRedashTextWidget(),
 			"redash_visualization":                resourceRedashVisualization(),
+			"redash_query_snippet":                resourceRedashQuerySnippet(),
+			"redash_access_permission":            resourceRedashAccessPermission(),
+			"redash_object_acl":                   resourceRedashObjectACL(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func resourceRedashAccessPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRedashAccessPermissionCreate,
+		ReadContext:   resourceRedashAccessPermissionRead,
+		DeleteContext: resourceRedashAccessPermissionDelete,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lo.Keys(aclObjectTypes), false),
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "modify",
+				ValidateFunc: validation.StringInSlice(aclAccessTypes, false),
+			},
+		},
+	}
+}
+
+func resourceRedashAccessPermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	objectType := d.Get("object_type").(string)
+	objectID := d.Get("object_id").(int)
+	payload := ObjectPermissionPayload{
+		AccessType: d.Get("access_type").(string),
+		UserID:     d.Get("user_id").(int),
+	}
+
+	err := grantObjectPermission(c, objectType, objectID, &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/%s/%d", objectType, objectID, payload.AccessType, payload.UserID))
+	diags = append(diags, resourceRedashAccessPermissionRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashAccessPermissionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	objectType := d.Get("object_type").(string)
+	objectID := d.Get("object_id").(int)
+	accessType := d.Get("access_type").(string)
+	userID := d.Get("user_id").(int)
+
+	acl, err := getObjectACL(c, objectType, objectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if lo.ContainsBy(acl[accessType], func(user ObjectACLUser) bool { return user.ID == userID }) {
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceRedashAccessPermissionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	payload := ObjectPermissionPayload{
+		AccessType: d.Get("access_type").(string),
+		UserID:     d.Get("user_id").(int),
+	}
+
+	err := revokeObjectPermission(c, d.Get("object_type").(string), d.Get("object_id").(int), &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
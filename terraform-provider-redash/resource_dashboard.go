@@ -35,7 +35,8 @@ func resourceRedashDashboard() *schema.Resource {
 			// State
 			"is_favorite": {
 				Type:     schema.TypeBool,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"is_archived": {
 				Type:     schema.TypeBool,
@@ -140,6 +141,14 @@ func resourceRedashDashboardCreate(_ context.Context, d *schema.ResourceData, me
 	_ = d.Set("dashboard_id", dashboard.ID)
 	_ = d.Set("slug", dashboard.Slug)
 
+	// Favorites are not part of the dashboard payload
+	if !d.GetRawConfig().GetAttr("is_favorite").IsNull() {
+		err = setFavorite(c, "/api/dashboards/"+dashboard.Slug, d.Get("is_favorite").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return diags
 }
 
@@ -174,6 +183,14 @@ func resourceRedashDashboardUpdate(_ context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	// Favorites are not part of the dashboard payload
+	if d.HasChange("is_favorite") {
+		err = setFavorite(c, "/api/dashboards/"+d.Get("slug").(string), d.Get("is_favorite").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return diags
 }
 
@@ -18,14 +18,51 @@ import (
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 )
 
+// Permissions known to Redash which can be granted to a group
+var groupPermissions = []string{
+	"admin",
+	"super_admin",
+	"create_dashboard",
+	"create_query",
+	"edit_dashboard",
+	"edit_query",
+	"view_query",
+	"view_source",
+	"execute_query",
+	"list_users",
+	"schedule_query",
+	"list_dashboards",
+	"list_alerts",
+	"list_data_sources",
+}
+
+// Permissions Redash grants to the groups it creates
+var groupDefaultPermissions = []string{
+	"create_dashboard",
+	"create_query",
+	"edit_dashboard",
+	"edit_query",
+	"view_query",
+	"view_source",
+	"execute_query",
+	"list_users",
+	"schedule_query",
+	"list_dashboards",
+	"list_alerts",
+	"list_data_sources",
+}
+
 func resourceRedashGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceRedashGroupCreate,
 		ReadContext:   resourceRedashGroupRead,
 		UpdateContext: resourceRedashGroupUpdate,
 		DeleteContext: resourceRedashGroupDelete,
+		CustomizeDiff: resourceRedashGroupCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -35,11 +72,15 @@ func resourceRedashGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			// Stock Redash does not allow changing group permissions through its
+			// API, so configured permissions are checked against the group at plan
 			"permissions": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
+				Optional: true,
 				Computed: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(groupPermissions, false),
 				},
 			},
 		},
@@ -62,7 +103,7 @@ func resourceRedashGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(group.ID))
 
-	resourceRedashGroupRead(ctx, d, meta)
+	diags = append(diags, resourceRedashGroupRead(ctx, d, meta)...)
 
 	return diags
 }
@@ -84,7 +125,7 @@ func resourceRedashGroupRead(_ context.Context, d *schema.ResourceData, meta int
 
 	_ = d.Set("name", &group.Name)
 	_ = d.Set("type", &group.Type)
-	_ = d.Set("permissions", &group.Permissions)
+	_ = d.Set("permissions", group.Permissions)
 
 	d.SetId(fmt.Sprint(group.ID))
 
@@ -94,22 +135,23 @@ func resourceRedashGroupRead(_ context.Context, d *schema.ResourceData, meta int
 func resourceRedashGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*redash.Client)
 
+	var diags diag.Diagnostics
+
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	payload := redash.Group{
+	_, err = updateGroup(c, id, &GroupUpdatePayload{
 		Name: d.Get("name").(string),
-		Type: d.Get("type").(string),
-	}
-
-	_, err = c.UpdateGroup(id, &payload)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return resourceRedashGroupRead(ctx, d, meta)
+	diags = append(diags, resourceRedashGroupRead(ctx, d, meta)...)
+
+	return diags
 }
 
 func resourceRedashGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -131,3 +173,37 @@ func resourceRedashGroupDelete(_ context.Context, d *schema.ResourceData, meta i
 
 	return diags
 }
+
+// resourceRedashGroupCustomizeDiff fails the plan when the configured
+// permissions differ from the permissions the group has, or is created with,
+// as stock Redash ignores permissions sent to its API and only allows
+// changing them through its management CLI
+func resourceRedashGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.GetRawConfig().GetAttr("permissions").IsNull() || !diff.NewValueKnown("permissions") {
+		return nil
+	}
+
+	current := groupDefaultPermissions
+	if diff.Id() != "" {
+		old, _ := diff.GetChange("permissions")
+		current = resourceRedashGroupPermissions(old.(*schema.Set))
+	}
+	configured := resourceRedashGroupPermissions(diff.Get("permissions").(*schema.Set))
+
+	missing, extra := lo.Difference(configured, current)
+	if len(missing) > 0 || len(extra) > 0 {
+		return fmt.Errorf(
+			"permissions of group %q cannot be changed through the Redash API (to grant: %v, to revoke: %v), "+
+				"change them with `manage.py groups change_permissions` first",
+			diff.Get("name").(string), missing, extra,
+		)
+	}
+
+	return nil
+}
+
+func resourceRedashGroupPermissions(permissions *schema.Set) []string {
+	return lo.Map(permissions.List(), func(item interface{}, _ int) string {
+		return item.(string)
+	})
+}
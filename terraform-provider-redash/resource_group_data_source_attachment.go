@@ -24,6 +24,7 @@ func resourceRedashGroupDataSourceAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceRedashGroupDataSourceAttachmentCreate,
 		ReadContext:   resourceRedashGroupDataSourceAttachmentRead,
+		UpdateContext: resourceRedashGroupDataSourceAttachmentUpdate,
 		DeleteContext: resourceRedashGroupDataSourceAttachmentDelete,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -36,6 +37,11 @@ func resourceRedashGroupDataSourceAttachment() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"view_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -53,6 +59,14 @@ func resourceRedashGroupDataSourceAttachmentCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
+	// Redash always grants full access when attaching a data source
+	if viewOnly := d.Get("view_only").(bool); viewOnly {
+		err = updateGroupDataSourcePermission(c, groupID, dataSourceID, viewOnly)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%d-%d", groupID, dataSourceID)))
 
 	return diags
@@ -71,7 +85,8 @@ func resourceRedashGroupDataSourceAttachmentRead(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
-	if _, ok := dataSource.Groups[groupID]; ok {
+	if viewOnly, ok := dataSource.Groups[groupID]; ok {
+		_ = d.Set("view_only", viewOnly)
 		return diags
 	}
 
@@ -80,6 +95,20 @@ func resourceRedashGroupDataSourceAttachmentRead(ctx context.Context, d *schema.
 	return diags
 }
 
+func resourceRedashGroupDataSourceAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	groupID := d.Get("group_id").(int)
+	dataSourceID := d.Get("data_source_id").(int)
+
+	err := updateGroupDataSourcePermission(c, groupID, dataSourceID, d.Get("view_only").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRedashGroupDataSourceAttachmentRead(ctx, d, meta)
+}
+
 func resourceRedashGroupDataSourceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*redash.Client)
 
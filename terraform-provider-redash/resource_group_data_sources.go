@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+)
+
+func resourceRedashGroupDataSources() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRedashGroupDataSourcesCreate,
+		ReadContext:   resourceRedashGroupDataSourcesRead,
+		UpdateContext: resourceRedashGroupDataSourcesUpdate,
+		DeleteContext: resourceRedashGroupDataSourcesDelete,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_source": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_source_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"view_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRedashGroupDataSourcesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	dataSources, err := getGroupDataSources(c, d.Get("group_id").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("data_source", lo.Map(dataSources, func(dataSource GroupDataSourceItem, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"data_source_id": dataSource.ID,
+			"view_only":      dataSource.ViewOnly,
+		}
+	}))
+
+	return diags
+}
+
+// resourceRedashGroupDataSourcesApply attaches every declared data source to the
+// group with the declared access and detaches any data source which is not declared
+func resourceRedashGroupDataSourcesApply(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*redash.Client)
+
+	groupID := d.Get("group_id").(int)
+
+	dataSources, err := getGroupDataSources(c, groupID)
+	if err != nil {
+		return err
+	}
+
+	current := lo.SliceToMap(dataSources, func(dataSource GroupDataSourceItem) (int, bool) {
+		return dataSource.ID, dataSource.ViewOnly
+	})
+	desired := lo.SliceToMap(d.Get("data_source").(*schema.Set).List(), func(item interface{}) (int, bool) {
+		dataSource := item.(map[string]interface{})
+		return dataSource["data_source_id"].(int), dataSource["view_only"].(bool)
+	})
+
+	for dataSourceID := range current {
+		if _, ok := desired[dataSourceID]; !ok {
+			err = c.GroupRemoveDataSource(groupID, dataSourceID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for dataSourceID, viewOnly := range desired {
+		currentViewOnly, attached := current[dataSourceID]
+		if !attached {
+			err = c.GroupAddDataSource(groupID, dataSourceID)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Redash always grants full access when attaching a data source
+		if (!attached && viewOnly) || (attached && currentViewOnly != viewOnly) {
+			err = updateGroupDataSourcePermission(c, groupID, dataSourceID, viewOnly)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceRedashGroupDataSourcesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := resourceRedashGroupDataSourcesApply(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(d.Get("group_id").(int)))
+	diags = append(diags, resourceRedashGroupDataSourcesRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashGroupDataSourcesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := resourceRedashGroupDataSourcesApply(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = append(diags, resourceRedashGroupDataSourcesRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashGroupDataSourcesDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	groupID := d.Get("group_id").(int)
+
+	for _, item := range d.Get("data_source").(*schema.Set).List() {
+		err := c.GroupRemoveDataSource(groupID, item.(map[string]interface{})["data_source_id"].(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+)
+
+func resourceRedashGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRedashGroupMemberCreate,
+		ReadContext:   resourceRedashGroupMemberRead,
+		DeleteContext: resourceRedashGroupMemberDelete,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRedashGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	groupID := d.Get("group_id").(int)
+	userID := d.Get("user_id").(int)
+
+	err := c.GroupAddUser(groupID, userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", groupID, userID))
+
+	return diags
+}
+
+func resourceRedashGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	groupID := d.Get("group_id").(int)
+	userID := d.Get("user_id").(int)
+
+	members, err := getGroupMembers(c, groupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if lo.ContainsBy(members, func(member GroupMember) bool { return member.ID == userID }) {
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceRedashGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	groupID := d.Get("group_id").(int)
+	userID := d.Get("user_id").(int)
+
+	err := c.GroupRemoveUser(groupID, userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
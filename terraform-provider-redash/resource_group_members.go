@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+)
+
+func resourceRedashGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRedashGroupMembersCreate,
+		ReadContext:   resourceRedashGroupMembersRead,
+		UpdateContext: resourceRedashGroupMembersUpdate,
+		DeleteContext: resourceRedashGroupMembersDelete,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceRedashGroupMembersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	members, err := getGroupMembers(c, d.Get("group_id").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("user_ids", lo.Map(members, func(member GroupMember, _ int) int {
+		return member.ID
+	}))
+
+	return diags
+}
+
+// resourceRedashGroupMembersApply adds every declared user to the group and
+// removes any member which is not declared
+func resourceRedashGroupMembersApply(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*redash.Client)
+
+	groupID := d.Get("group_id").(int)
+
+	members, err := getGroupMembers(c, groupID)
+	if err != nil {
+		return err
+	}
+
+	current := lo.Map(members, func(member GroupMember, _ int) int {
+		return member.ID
+	})
+	desired := lo.Map(d.Get("user_ids").(*schema.Set).List(), func(item interface{}, _ int) int {
+		return item.(int)
+	})
+
+	toRemove, toAdd := lo.Difference(current, desired)
+
+	for _, userID := range toRemove {
+		err = c.GroupRemoveUser(groupID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, userID := range toAdd {
+		err = c.GroupAddUser(groupID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceRedashGroupMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := resourceRedashGroupMembersApply(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(d.Get("group_id").(int)))
+	diags = append(diags, resourceRedashGroupMembersRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := resourceRedashGroupMembersApply(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = append(diags, resourceRedashGroupMembersRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashGroupMembersDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	groupID := d.Get("group_id").(int)
+
+	for _, item := range d.Get("user_ids").(*schema.Set).List() {
+		err := c.GroupRemoveUser(groupID, item.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func resourceRedashObjectACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRedashObjectACLCreate,
+		ReadContext:   resourceRedashObjectACLRead,
+		UpdateContext: resourceRedashObjectACLUpdate,
+		DeleteContext: resourceRedashObjectACLDelete,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lo.Keys(aclObjectTypes), false),
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"grant": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"access_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "modify",
+							ValidateFunc: validation.StringInSlice(aclAccessTypes, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// objectACLGrants flattens an object access control list into a list of grants
+func objectACLGrants(acl ObjectACL) []ObjectPermissionPayload {
+	grants := []ObjectPermissionPayload{}
+	for accessType, users := range acl {
+		for _, user := range users {
+			grants = append(grants, ObjectPermissionPayload{
+				AccessType: accessType,
+				UserID:     user.ID,
+			})
+		}
+	}
+
+	return grants
+}
+
+func resourceRedashObjectACLRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	acl, err := getObjectACL(c, d.Get("object_type").(string), d.Get("object_id").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("grant", lo.Map(objectACLGrants(acl), func(grant ObjectPermissionPayload, _ int) map[string]interface{} {
+		return map[string]interface{}{
+			"user_id":     grant.UserID,
+			"access_type": grant.AccessType,
+		}
+	}))
+
+	return diags
+}
+
+// resourceRedashObjectACLApply grants every declared permission and revokes any
+// permission present in Redash which is not declared
+func resourceRedashObjectACLApply(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*redash.Client)
+
+	objectType := d.Get("object_type").(string)
+	objectID := d.Get("object_id").(int)
+
+	acl, err := getObjectACL(c, objectType, objectID)
+	if err != nil {
+		return err
+	}
+
+	current := objectACLGrants(acl)
+	desired := lo.Map(d.Get("grant").(*schema.Set).List(), func(item interface{}, _ int) ObjectPermissionPayload {
+		grant := item.(map[string]interface{})
+		return ObjectPermissionPayload{
+			AccessType: grant["access_type"].(string),
+			UserID:     grant["user_id"].(int),
+		}
+	})
+
+	toRevoke, toGrant := lo.Difference(current, desired)
+
+	for _, grant := range toRevoke {
+		err = revokeObjectPermission(c, objectType, objectID, &grant)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, grant := range toGrant {
+		err = grantObjectPermission(c, objectType, objectID, &grant)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceRedashObjectACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := resourceRedashObjectACLApply(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", d.Get("object_type").(string), d.Get("object_id").(int)))
+	diags = append(diags, resourceRedashObjectACLRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashObjectACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := resourceRedashObjectACLApply(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = append(diags, resourceRedashObjectACLRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashObjectACLDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	objectType := d.Get("object_type").(string)
+	objectID := d.Get("object_id").(int)
+
+	acl, err := getObjectACL(c, objectType, objectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, grant := range objectACLGrants(acl) {
+		err = revokeObjectPermission(c, objectType, objectID, &grant)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -2,8 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +22,11 @@ func resourceRedashQuery() *schema.Resource {
 		ReadContext:   resourceRedashQueryRead,
 		UpdateContext: resourceRedashQueryUpdate,
 		DeleteContext: resourceRedashQueryArchive,
+		CustomizeDiff: resourceRedashQueryCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			// Base Data
 			"query_id": {
@@ -31,14 +41,26 @@ func resourceRedashQuery() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"fork_from_query_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
 			// Query
 			"data_source_id": {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
 			"query": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"query", "query_file"},
+			},
+			"query_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"query", "query_file"},
 			},
 			"query_hash": {
 				Type:     schema.TypeString,
@@ -122,6 +144,25 @@ func resourceRedashQuery() *schema.Resource {
 					},
 				},
 			},
+			"infer_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"fail_on_unused_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Names of the parameters added by infer_parameters, which are not
+			// part of options as it only holds the declared parameters
+			"inferred_parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			// State
 			"is_draft": {
 				Type: schema.TypeBool,
@@ -184,9 +225,16 @@ func resourceRedashQuery() *schema.Resource {
 					},
 				},
 			},
+			// Validation
+			"validate_on_apply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			// Query Specific
 			"is_favorite": {
 				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"can_edit": {
@@ -223,6 +271,7 @@ func resourceRedashQueryRead(_ context.Context, d *schema.ResourceData, meta int
 	_ = d.Set("query_hash", query.QueryHash)
 	// Options
 	_ = d.Set("options", query.Options)
+	_ = d.Set("inferred_parameters", resourceRedashQueryInferredParameters(d, query))
 	// State
 	_ = d.Set("is_draft", query.IsDraft)
 	_ = d.Set("is_archived", query.IsArchived)
@@ -306,13 +355,22 @@ func resourceRedashQueryCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	queryText, err := resourceRedashQueryText(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("infer_parameters").(bool) {
+		options.Parameters = append(options.Parameters, queryInferParameters(queryText, options.Parameters)...)
+	}
+
 	createPayload := redash.QueryCreatePayload{
 		// Base Data
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 		// Query
 		DataSourceID: d.Get("data_source_id").(int),
-		Query:        d.Get("query").(string),
+		Query:        queryText,
 		QueryHash:    d.Get("query_hash").(string),
 		// Options
 		Options: options,
@@ -327,13 +385,44 @@ func resourceRedashQueryCreate(ctx context.Context, d *schema.ResourceData, meta
 		Schedule: schedule,
 	}
 
-	query, err := c.CreateQuery(&createPayload)
-	if err != nil {
-		return diag.FromErr(err)
+	var query *redash.Query
+	if forkFromQueryID, ok := d.GetOk("fork_from_query_id"); ok {
+		// Fork the query, which copies its visualizations, then apply the
+		// configured values to the fork
+		query, err = forkQuery(c, forkFromQueryID.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(strconv.Itoa(query.ID))
+
+		updatePayload := redash.QueryUpdatePayload(createPayload)
+		updatePayload.Version = query.Version
+		query, err = c.UpdateQuery(query.ID, &updatePayload)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		query, err = c.CreateQuery(&createPayload)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	d.SetId(strconv.Itoa(query.ID))
 	_ = d.Set("query_id", query.ID)
+
+	// Favorites are not part of the query payload
+	if !d.GetRawConfig().GetAttr("is_favorite").IsNull() {
+		err = setFavorite(c, fmt.Sprintf("/api/queries/%d", query.ID), d.Get("is_favorite").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("validate_on_apply").(bool) {
+		diags = append(diags, resourceRedashQueryValidate(ctx, c, query)...)
+	}
+
 	diags = append(diags, resourceRedashQueryRead(ctx, d, meta)...)
 
 	return diags
@@ -410,13 +499,22 @@ func resourceRedashQueryUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	queryText, err := resourceRedashQueryText(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("infer_parameters").(bool) {
+		options.Parameters = append(options.Parameters, queryInferParameters(queryText, options.Parameters)...)
+	}
+
 	updatePayload := redash.QueryUpdatePayload{
 		// Base Data
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 		// Query
 		DataSourceID: d.Get("data_source_id").(int),
-		Query:        d.Get("query").(string),
+		Query:        queryText,
 		QueryHash:    d.Get("query_hash").(string),
 		// Options
 		Options: options,
@@ -431,11 +529,24 @@ func resourceRedashQueryUpdate(ctx context.Context, d *schema.ResourceData, meta
 		Schedule: schedule,
 	}
 
-	_, err = c.UpdateQuery(id, &updatePayload)
+	query, err := c.UpdateQuery(id, &updatePayload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// Favorites are not part of the query payload
+	if d.HasChange("is_favorite") {
+		err = setFavorite(c, fmt.Sprintf("/api/queries/%d", id), d.Get("is_favorite").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Only changes to what is executed need validating
+	if d.Get("validate_on_apply").(bool) && d.HasChanges("query", "data_source_id", "options") {
+		diags = append(diags, resourceRedashQueryValidate(ctx, c, query)...)
+	}
+
 	diags = append(diags, resourceRedashQueryRead(ctx, d, meta)...)
 
 	return diags
@@ -460,3 +571,177 @@ func resourceRedashQueryArchive(_ context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+// resourceRedashQueryValidate executes the query against its data source with
+// the default parameter values and waits for it to finish, so that broken
+// queries fail the apply with the error reported by the data source
+func resourceRedashQueryValidate(ctx context.Context, c *redash.Client, query *redash.Query) diag.Diagnostics {
+	_, err := waitForQueryResult(ctx, c, query.ID, &QueryResultPayload{
+		Parameters: lo.SliceToMap(query.Options.Parameters, func(parameter redash.QueryOptionsParameter) (string, interface{}) {
+			return parameter.Name, parameter.Value
+		}),
+		MaxAge: 0,
+	})
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Query %d failed validation", query.ID),
+			Detail:   err.Error(),
+		}}
+	}
+
+	return nil
+}
+
+// resourceRedashQueryCustomizeDiff loads the text of query_file into query
+// during plan and checks that every parameter used in the query text is
+// declared in options.parameters, unless undeclared parameters are inferred,
+// in which case their names are planned in inferred_parameters. Declared
+// parameters which are not used fail the plan when requested
+func resourceRedashQueryCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("query_file") {
+		// The file is only known during apply, when it is read by create and update
+		return errors.Join(diff.SetNewComputed("query"), diff.SetNewComputed("inferred_parameters"))
+	}
+
+	queryText := diff.Get("query").(string)
+	if queryFile := diff.Get("query_file").(string); queryFile != "" {
+		rawQueryText, err := os.ReadFile(queryFile)
+		if err != nil {
+			return fmt.Errorf("unable to read query_file: %w", err)
+		}
+
+		if queryText != string(rawQueryText) {
+			queryText = string(rawQueryText)
+			err = diff.SetNew("query", queryText)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !diff.NewValueKnown("query") || !diff.NewValueKnown("options") {
+		return diff.SetNewComputed("inferred_parameters")
+	}
+
+	declared := queryDeclaredParameters(diff.Get("options.0.parameters"))
+	undeclared := lo.Map(queryInferParameters(queryText, declared), func(parameter redash.QueryOptionsParameter, _ int) string {
+		return parameter.Name
+	})
+
+	var errs []error
+	if diff.Get("infer_parameters").(bool) {
+		// Inferred parameters are planned so that they match the parameters
+		// read back from Redash
+		errs = append(errs, diff.SetNew("inferred_parameters", undeclared))
+	} else {
+		errs = append(errs, diff.SetNew("inferred_parameters", []string{}))
+		if len(undeclared) > 0 {
+			errs = append(errs, fmt.Errorf(
+				"query uses parameters which are not declared in options.parameters: %s. Declare them or set infer_parameters to declare them as text parameters",
+				strings.Join(undeclared, ", "),
+			))
+		}
+	}
+
+	if diff.Get("fail_on_unused_parameters").(bool) {
+		used := queryParameterNames(queryText)
+		unused := lo.FilterMap(declared, func(parameter redash.QueryOptionsParameter, _ int) (string, bool) {
+			return parameter.Name, !lo.Contains(used, parameter.Name)
+		})
+		if len(unused) > 0 {
+			errs = append(errs, fmt.Errorf(
+				"options.parameters declares parameters which are not used in the query: %s",
+				strings.Join(unused, ", "),
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// resourceRedashQueryInferredParameters returns the names of the parameters
+// of a query which were added by infer_parameters: those which are used in the
+// query text but not declared in options.parameters
+func resourceRedashQueryInferredParameters(d *schema.ResourceData, query *redash.Query) []string {
+	if !d.Get("infer_parameters").(bool) {
+		return []string{}
+	}
+
+	declared := queryDeclaredParameters(d.Get("options.0.parameters"))
+	used := queryParameterNames(query.Query)
+
+	return lo.FilterMap(query.Options.Parameters, func(parameter redash.QueryOptionsParameter, _ int) (string, bool) {
+		return parameter.Name, lo.Contains(used, parameter.Name) && !lo.ContainsBy(declared, func(item redash.QueryOptionsParameter) bool {
+			return item.Name == parameter.Name
+		})
+	})
+}
+
+// resourceRedashQueryText returns the text of the query, read from query_file
+// when it is set
+func resourceRedashQueryText(d *schema.ResourceData) (string, error) {
+	queryFile := d.Get("query_file").(string)
+	if queryFile == "" {
+		return d.Get("query").(string), nil
+	}
+
+	queryText, err := os.ReadFile(queryFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read query_file: %w", err)
+	}
+
+	return string(queryText), nil
+}
+
+// Matches the mustache tags of a query text, such as {{ name }} or {{ range.start }}
+var queryParameterRegexp = regexp.MustCompile(`{{\s*([^{}]+?)\s*}}`)
+
+// queryParameterNames returns the names of the parameters used in a query
+// text, in order of first use. Properties of a parameter, such as the start
+// and end of a date range, refer to the parameter itself. Mustache sections,
+// partials and comments are not parameters
+func queryParameterNames(queryText string) []string {
+	names := []string{}
+	for _, match := range queryParameterRegexp.FindAllStringSubmatch(queryText, -1) {
+		name := match[1]
+		if strings.ContainsAny(name[:1], "#^/!>") {
+			continue
+		}
+
+		name = strings.TrimSpace(strings.TrimPrefix(name, "&"))
+		name, _, _ = strings.Cut(name, ".")
+		names = append(names, name)
+	}
+
+	return lo.Uniq(names)
+}
+
+// queryDeclaredParameters returns the parameters declared in an
+// options.parameters attribute, holding only their names
+func queryDeclaredParameters(parameters interface{}) []redash.QueryOptionsParameter {
+	items, _ := parameters.([]interface{})
+
+	return lo.Map(items, func(item interface{}, _ int) redash.QueryOptionsParameter {
+		return redash.QueryOptionsParameter{Name: item.(map[string]interface{})["name"].(string)}
+	})
+}
+
+// queryInferParameters returns text parameters for the parameters used in the
+// query text which are not declared, as the Redash editor adds them
+func queryInferParameters(queryText string, declared []redash.QueryOptionsParameter) []redash.QueryOptionsParameter {
+	undeclared := lo.Filter(queryParameterNames(queryText), func(name string, _ int) bool {
+		return !lo.ContainsBy(declared, func(parameter redash.QueryOptionsParameter) bool {
+			return parameter.Name == name
+		})
+	})
+
+	return lo.Map(undeclared, func(name string, _ int) redash.QueryOptionsParameter {
+		return redash.QueryOptionsParameter{
+			Name:  name,
+			Title: name,
+			Type:  "text",
+			Value: "",
+		}
+	})
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+)
+
+func resourceRedashQueryClone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRedashQueryCloneCreate,
+		ReadContext:   resourceRedashQueryCloneRead,
+		DeleteContext: resourceRedashQueryArchive,
+		Schema: map[string]*schema.Schema{
+			// Source
+			"source_query_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_redash_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_api_key"},
+			},
+			"source_api_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"source_redash_uri"},
+			},
+			// Base Data
+			"query_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// Query
+			"data_source_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			// Visualizations
+			"visualization_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceRedashQueryCloneRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	query, err := c.GetQuery(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Base Data
+	_ = d.Set("query_id", query.ID)
+	_ = d.Set("name", query.Name)
+	// Query
+	_ = d.Set("data_source_id", query.DataSourceID)
+
+	return diags
+}
+
+func resourceRedashQueryCloneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	sourceClient := c
+	if sourceRedashURI, ok := d.GetOk("source_redash_uri"); ok {
+		var err error
+		sourceClient, err = redash.NewClient(&redash.Config{
+			RedashURI: sourceRedashURI.(string),
+			APIKey:    d.Get("source_api_key").(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	source, err := sourceClient.GetQuery(d.Get("source_query_id").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := source.Options
+	if sourceClient != c {
+		options, err = queryCloneOptions(source)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	query, err := c.CreateQuery(&redash.QueryCreatePayload{
+		// Base Data
+		Name:        lo.Ternary(d.Get("name").(string) != "", d.Get("name").(string), source.Name),
+		Description: source.Description,
+		// Query
+		DataSourceID: d.Get("data_source_id").(int),
+		Query:        source.Query,
+		// Options
+		Options: options,
+		// State
+		IsDraft: source.IsDraft,
+		// Metadata
+		Tags: source.Tags,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The copy is only tracked once its visualizations are copied, so it is
+	// archived when copying them fails instead of being left half cloned
+	visualizationIDs, err := queryCloneVisualizations(c, source, query.ID)
+	if err != nil {
+		if archiveErr := c.ArchiveQuery(query.ID); archiveErr != nil {
+			err = errors.Join(err, fmt.Errorf("unable to archive query %d: %w", query.ID, archiveErr))
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(query.ID))
+	_ = d.Set("query_id", query.ID)
+	_ = d.Set("visualization_ids", visualizationIDs)
+
+	diags = append(diags, resourceRedashQueryCloneRead(ctx, d, meta)...)
+
+	return diags
+}
+
+// queryCloneOptions returns the options of a query cloned from another Redash
+// instance. Query based dropdown parameters read their values from a query of
+// the source instance, so they are rejected, and the parent query IDs of the
+// other parameters, which are source instance query IDs, are cleared
+func queryCloneOptions(source *redash.Query) (redash.QueryOptions, error) {
+	dropdowns := lo.FilterMap(source.Options.Parameters, func(parameter redash.QueryOptionsParameter, _ int) (string, bool) {
+		return parameter.Name, parameter.Type == "query"
+	})
+	if len(dropdowns) > 0 {
+		return redash.QueryOptions{}, fmt.Errorf(
+			"query %d has query based dropdown parameters, which cannot be cloned from another Redash instance: %s",
+			source.ID, strings.Join(dropdowns, ", "),
+		)
+	}
+
+	return redash.QueryOptions{
+		Parameters: lo.Map(source.Options.Parameters, func(parameter redash.QueryOptionsParameter, _ int) redash.QueryOptionsParameter {
+			parameter.ParentQueryId = 0
+			return parameter
+		}),
+	}, nil
+}
+
+// queryCloneVisualizations copies the visualizations of a source query to a
+// cloned query and returns the IDs of the copies keyed by the IDs of the
+// source visualizations. Redash creates a default table visualization with
+// every query, which is reused for the first table of the source query
+func queryCloneVisualizations(c *redash.Client, source *redash.Query, queryID int) (map[string]interface{}, error) {
+	query, err := c.GetQuery(queryID)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTables := lo.Filter(query.Visualizations, func(visualization redash.VisualizationQuery, _ int) bool {
+		return visualization.Type == "TABLE"
+	})
+
+	visualizationIDs := map[string]interface{}{}
+	for _, visualization := range source.Visualizations {
+		var clone *redash.VisualizationQuery
+		if visualization.Type == "TABLE" && len(defaultTables) > 0 {
+			clone, err = c.UpdateVisualization(defaultTables[0].ID, &redash.VisualizationUpdatePayload{
+				Name:        visualization.Name,
+				Description: visualization.Description,
+				Type:        visualization.Type,
+				Options:     visualization.Options,
+			})
+			defaultTables = defaultTables[1:]
+		} else {
+			clone, err = c.CreateVisualization(&redash.VisualizationCreatePayload{
+				Name:        visualization.Name,
+				Description: visualization.Description,
+				Type:        visualization.Type,
+				Options:     visualization.Options,
+				QueryId:     queryID,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		visualizationIDs[strconv.Itoa(visualization.ID)] = clone.ID
+	}
+
+	return visualizationIDs, nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+)
+
+func TestQueryCloneOptions(t *testing.T) {
+	source := &redash.Query{ID: 42}
+	source.Options.Parameters = []redash.QueryOptionsParameter{
+		{Name: "country", Type: "text", ParentQueryId: 42},
+		{Name: "limit", Type: "number"},
+	}
+
+	options, err := queryCloneOptions(source)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(options.Parameters) != 2 || options.Parameters[0].Name != "country" || options.Parameters[0].ParentQueryId != 0 {
+		t.Errorf("expected parent query IDs to be cleared, got %+v", options.Parameters)
+	}
+	if source.Options.Parameters[0].ParentQueryId != 42 {
+		t.Errorf("expected the source query not to be modified, got %+v", source.Options.Parameters)
+	}
+
+	source.Options.Parameters = append(source.Options.Parameters, redash.QueryOptionsParameter{Name: "tenant", Type: "query"})
+	_, err = queryCloneOptions(source)
+	if err == nil || !strings.Contains(err.Error(), "query based dropdown parameters") || !strings.Contains(err.Error(), "tenant") {
+		t.Errorf("expected query based dropdown parameters to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRedashQuerySnippet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRedashQuerySnippetCreate,
+		ReadContext:   resourceRedashQuerySnippetRead,
+		UpdateContext: resourceRedashQuerySnippetUpdate,
+		DeleteContext: resourceRedashQuerySnippetDelete,
+		Schema: map[string]*schema.Schema{
+			// Base Data
+			"query_snippet_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"trigger": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"snippet": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Timestamps
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRedashQuerySnippetRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	querySnippet, err := getQuerySnippet(c, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Base Data
+	_ = d.Set("query_snippet_id", querySnippet.ID)
+	_ = d.Set("trigger", querySnippet.Trigger)
+	_ = d.Set("description", querySnippet.Description)
+	_ = d.Set("snippet", querySnippet.Snippet)
+	// Timestamps
+	_ = d.Set("created_at", querySnippet.CreatedAt.Format(time.RFC3339))
+	_ = d.Set("updated_at", querySnippet.UpdatedAt.Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceRedashQuerySnippetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	payload := QuerySnippetPayload{
+		Trigger:     d.Get("trigger").(string),
+		Description: d.Get("description").(string),
+		Snippet:     d.Get("snippet").(string),
+	}
+
+	querySnippet, err := createQuerySnippet(c, &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(querySnippet.ID))
+	diags = append(diags, resourceRedashQuerySnippetRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashQuerySnippetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := QuerySnippetPayload{
+		Trigger:     d.Get("trigger").(string),
+		Description: d.Get("description").(string),
+		Snippet:     d.Get("snippet").(string),
+	}
+
+	_, err = updateQuerySnippet(c, id, &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = append(diags, resourceRedashQuerySnippetRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashQuerySnippetDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = deleteQuerySnippet(c, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestQueryParameterNames(t *testing.T) {
+	cases := []struct {
+		name      string
+		queryText string
+		names     []string
+	}{
+		{
+			name:      "no parameters",
+			queryText: "SELECT 1",
+			names:     []string{},
+		},
+		{
+			name:      "parameters in order of first use",
+			queryText: "SELECT * FROM t WHERE b = '{{ b }}' AND a = {{a}} OR b = '{{  b  }}'",
+			names:     []string{"b", "a"},
+		},
+		{
+			name:      "date range properties",
+			queryText: "WHERE d BETWEEN '{{ period.start }}' AND '{{ period.end }}'",
+			names:     []string{"period"},
+		},
+		{
+			name:      "unescaped parameters",
+			queryText: "WHERE c IN ({{& countries }})",
+			names:     []string{"countries"},
+		},
+		{
+			name:      "sections, partials and comments",
+			queryText: "{{# filter }}WHERE x = {{ x }}{{/ filter }}{{^ empty }}{{/ empty }}{{! note }}{{> partial }}",
+			names:     []string{"x"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if names := queryParameterNames(tc.queryText); !reflect.DeepEqual(names, tc.names) {
+				t.Errorf("expected %v, got %v", tc.names, names)
+			}
+		})
+	}
+}
+
+func TestQueryInferParameters(t *testing.T) {
+	parameters := queryInferParameters(
+		"SELECT * FROM t WHERE a = {{ a }} AND b = {{ b }} AND c = {{ c }}",
+		[]redash.QueryOptionsParameter{{Name: "b"}, {Name: "unused"}},
+	)
+
+	expected := []redash.QueryOptionsParameter{
+		{Name: "a", Title: "a", Type: "text", Value: ""},
+		{Name: "c", Title: "c", Type: "text", Value: ""},
+	}
+	if !reflect.DeepEqual(parameters, expected) {
+		t.Errorf("expected %+v, got %+v", expected, parameters)
+	}
+
+	if parameters := queryInferParameters("SELECT {{ a }}", []redash.QueryOptionsParameter{{Name: "a"}}); len(parameters) != 0 {
+		t.Errorf("expected no parameters, got %+v", parameters)
+	}
+}
+
+func TestResourceRedashQueryInferredParameters(t *testing.T) {
+	query := &redash.Query{Query: "SELECT * FROM t WHERE a = {{ a }} AND b = {{ b }}"}
+	query.Options.Parameters = []redash.QueryOptionsParameter{
+		{Name: "b", Type: "number"},
+		{Name: "a", Type: "text"},
+		{Name: "stale", Type: "text"},
+	}
+
+	declared := []interface{}{
+		map[string]interface{}{"name": "b", "title": "B", "type": "number", "global": false},
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		inferred []string
+	}{
+		{
+			name: "inferred parameters",
+			config: map[string]interface{}{
+				"infer_parameters": true,
+				"options":          []interface{}{map[string]interface{}{"parameters": declared}},
+			},
+			inferred: []string{"a"},
+		},
+		{
+			name: "without declared parameters",
+			config: map[string]interface{}{
+				"infer_parameters": true,
+			},
+			inferred: []string{"b", "a"},
+		},
+		{
+			name: "infer_parameters not set",
+			config: map[string]interface{}{
+				"options": []interface{}{map[string]interface{}{"parameters": declared}},
+			},
+			inferred: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceRedashQuery().Schema, tc.config)
+			if inferred := resourceRedashQueryInferredParameters(d, query); !reflect.DeepEqual(inferred, tc.inferred) {
+				t.Errorf("expected %v, got %v", tc.inferred, inferred)
+			}
+		})
+	}
+}
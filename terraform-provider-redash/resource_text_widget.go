@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRedashTextWidget() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceRedashTextWidgetRead,
+		CreateContext: resourceRedashTextWidgetCreate,
+		UpdateContext: resourceRedashTextWidgetUpdate,
+		DeleteContext: resourceRedashWidgetDelete,
+		CustomizeDiff: resourceRedashTextWidgetCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			// Base Data
+			"widget_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dashboard_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dashboard_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// Content
+			"text": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"text", "text_file"},
+			},
+			"text_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"text", "text_file"},
+			},
+			"width": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			// Options
+			"is_hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"position": widgetPositionSchema(),
+		},
+	}
+}
+
+func resourceRedashTextWidgetRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	widget, err := c.GetWidget(d.Get("dashboard_slug").(string), id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if widget.Visualization.ID != 0 {
+		return diag.Errorf("Widget %d displays visualization %d and is not a text widget", widget.ID, widget.Visualization.ID)
+	}
+
+	// Base Data
+	_ = d.Set("widget_id", widget.ID)
+	_ = d.Set("dashboard_id", widget.DashboardID)
+	// Content
+	_ = d.Set("text", widget.Text)
+	_ = d.Set("width", widget.Width)
+	// Options
+	_ = d.Set("is_hidden", widget.Options.IsHidden)
+	_ = d.Set("position", widgetFlattenPosition(widget.Options.Position))
+
+	return diags
+}
+
+func resourceRedashTextWidgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	// Widgets of a dashboard are created one at a time so that each one is
+	// placed below the widgets created before it
+	unlock := lockDashboardWidgets(c, d.Get("dashboard_slug").(string))
+	defer unlock()
+
+	dashboard, err := c.GetDashboard(d.Get("dashboard_slug").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	text, err := resourceRedashTextWidgetText(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := resourceRedashTextWidgetOptions(d)
+
+	// Place the widget at the bottom of the dashboard when no row is given
+	if !configuredInRawConfig(d.GetRawConfig(), "position", "row") {
+		options.Position.Row = widgetBottomRow(dashboard)
+	}
+
+	widget, err := createWidget(c, &WidgetPayload{
+		// Base Data
+		DashboardID: dashboard.ID,
+		//
+		Text:  text,
+		Width: d.Get("width").(int),
+		// Options
+		Options: options,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(widget.ID))
+	_ = d.Set("widget_id", widget.ID)
+	_ = d.Set("dashboard_id", dashboard.ID)
+
+	diags = append(diags, resourceRedashTextWidgetRead(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceRedashTextWidgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	text, err := resourceRedashTextWidgetText(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = updateWidget(c, id, &WidgetPayload{
+		//
+		Text:  text,
+		Width: d.Get("width").(int),
+		// Options
+		Options: resourceRedashTextWidgetOptions(d),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = append(diags, resourceRedashTextWidgetRead(ctx, d, meta)...)
+
+	return diags
+}
+
+// resourceRedashTextWidgetCustomizeDiff loads the markdown of text_file into
+// text during plan, so changes to the file and changes made to the widget in
+// Redash both show up as a diff of text
+func resourceRedashTextWidgetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("text_file") {
+		// The file is only known during apply, when it is read by create and update
+		return diff.SetNewComputed("text")
+	}
+
+	textFile := diff.Get("text_file").(string)
+	if textFile == "" {
+		return nil
+	}
+
+	text, err := os.ReadFile(textFile)
+	if err != nil {
+		return fmt.Errorf("unable to read text_file: %w", err)
+	}
+
+	if diff.Get("text").(string) != string(text) {
+		return diff.SetNew("text", string(text))
+	}
+
+	return nil
+}
+
+// resourceRedashTextWidgetText returns the markdown of the widget, read from
+// text_file when it is set
+func resourceRedashTextWidgetText(d *schema.ResourceData) (string, error) {
+	textFile := d.Get("text_file").(string)
+	if textFile == "" {
+		return d.Get("text").(string), nil
+	}
+
+	text, err := os.ReadFile(textFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read text_file: %w", err)
+	}
+
+	return string(text), nil
+}
+
+// resourceRedashTextWidgetOptions builds the API options of the widget. Text
+// widgets have no parameters, so only visibility and position are set
+func resourceRedashTextWidgetOptions(d *schema.ResourceData) redash.WidgetOptions {
+	dPosition := map[string]interface{}{}
+	if position := d.Get("position").([]interface{}); len(position) > 0 && position[0] != nil {
+		dPosition = position[0].(map[string]interface{})
+	}
+
+	return redash.WidgetOptions{
+		IsHidden:          d.Get("is_hidden").(bool),
+		Position:          widgetExpandPosition(dPosition),
+		ParameterMappings: map[string]redash.WidgetParameterMapping{},
+	}
+}
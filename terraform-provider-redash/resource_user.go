@@ -18,6 +18,7 @@ import (
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
 )
 
 func resourceRedashUser() *schema.Resource {
@@ -38,16 +39,37 @@ func resourceRedashUser() *schema.Resource {
 			"groups": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
 			},
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"send_invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"resend_invite_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"invite_link": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"auth_type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"is_disabled": {
 				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"updated_at": {
@@ -92,41 +114,39 @@ func resourceRedashUserCreate(ctx context.Context, d *schema.ResourceData, meta
 		Email: d.Get("email").(string),
 	}
 
-	user, err := c.CreateUser(&createPayload)
+	user, err := createUser(c, &createPayload, d.Get("send_invite").(bool))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprint(user.ID))
+	_ = d.Set("invite_link", user.InviteLink)
 
-	groupIds := []int{}
-
-	if raw, ok := d.GetOk("groups"); ok {
-
-		list := raw.([]interface{})
-
-		for _, v := range list {
-			groupID, ok := v.(int)
-			if !ok {
-				return diag.FromErr(fmt.Errorf("invalid group_id found"))
-			}
-			groupIds = append(groupIds, groupID)
+	if !d.GetRawConfig().GetAttr("groups").IsNull() || d.Get("is_admin").(bool) {
+		adminGroupID, err := getAdminGroupID(c)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-	}
 
-	if len(groupIds) > 0 {
 		updatePayload := redash.UserUpdatePayload{
 			Name:   d.Get("name").(string),
 			Email:  d.Get("email").(string),
-			Groups: groupIds,
+			Groups: resourceRedashUserGroups(d, user.Groups, adminGroupID),
+		}
+		_, err = c.UpdateUser(user.ID, &updatePayload)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		_, err := c.UpdateUser(user.ID, &updatePayload)
+	}
+
+	if d.Get("is_disabled").(bool) {
+		err = c.DisableUser(user.ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	resourceRedashUserRead(ctx, d, meta)
+	diags = append(diags, resourceRedashUserRead(ctx, d, meta)...)
 
 	return diags
 }
@@ -146,9 +166,15 @@ func resourceRedashUserRead(_ context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	adminGroupID, err := getAdminGroupID(c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	_ = d.Set("name", &user.Name)
 	_ = d.Set("email", &user.Email)
-	_ = d.Set("groups", &user.Groups)
+	_ = d.Set("groups", lo.Without(user.Groups, adminGroupID))
+	_ = d.Set("is_admin", lo.Contains(user.Groups, adminGroupID))
 	_ = d.Set("auth_type", &user.AuthType)
 	_ = d.Set("is_disabled", &user.IsDisabled)
 	_ = d.Set("updated_at", &user.UpdatedAt)
@@ -167,30 +193,27 @@ func resourceRedashUserRead(_ context.Context, d *schema.ResourceData, meta inte
 func resourceRedashUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*redash.Client)
 
+	var diags diag.Diagnostics
+
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	groupIds := []int{}
-
-	if raw, ok := d.GetOk("groups"); ok {
-
-		list := raw.([]interface{})
+	user, err := c.GetUser(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		for _, v := range list {
-			groupID, ok := v.(int)
-			if !ok {
-				return diag.FromErr(fmt.Errorf("invalid group_id found"))
-			}
-			groupIds = append(groupIds, groupID)
-		}
+	adminGroupID, err := getAdminGroupID(c)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	updatePayload := redash.UserUpdatePayload{
 		Name:   d.Get("name").(string),
 		Email:  d.Get("email").(string),
-		Groups: groupIds,
+		Groups: resourceRedashUserGroups(d, user.Groups, adminGroupID),
 	}
 
 	_, err = c.UpdateUser(id, &updatePayload)
@@ -198,7 +221,53 @@ func resourceRedashUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	return resourceRedashUserRead(ctx, d, meta)
+	if d.HasChange("is_disabled") {
+		if d.Get("is_disabled").(bool) {
+			err = c.DisableUser(id)
+		} else {
+			err = enableUser(c, id)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("resend_invite_trigger") && user.IsInvitationPending {
+		invitation, err := resendUserInvitation(c, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_ = d.Set("invite_link", invitation.InviteLink)
+	}
+
+	diags = append(diags, resourceRedashUserRead(ctx, d, meta)...)
+
+	return diags
+}
+
+// resourceRedashUserGroups returns the group IDs to send to Redash. The built-in
+// admin group is managed through is_admin rather than groups, and unconfigured
+// arguments keep the user's current memberships.
+func resourceRedashUserGroups(d *schema.ResourceData, currentGroups []int, adminGroupID int) []int {
+	groupIDs := currentGroups
+	if !d.GetRawConfig().GetAttr("groups").IsNull() {
+		groupIDs = lo.Map(d.Get("groups").([]interface{}), func(item interface{}, _ int) int {
+			return item.(int)
+		})
+	}
+
+	isAdmin := lo.Contains(currentGroups, adminGroupID)
+	if !d.GetRawConfig().GetAttr("is_admin").IsNull() {
+		isAdmin = d.Get("is_admin").(bool)
+	}
+
+	groupIDs = lo.Without(groupIDs, adminGroupID)
+	if isAdmin {
+		groupIDs = append(groupIDs, adminGroupID)
+	}
+
+	return groupIDs
 }
 
 func resourceRedashUserDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -2,11 +2,16 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/AlmirKadric/redash-client-go/redash"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/samber/lo"
 )
 
@@ -16,6 +21,10 @@ func resourceRedashVisualization() *schema.Resource {
 		CreateContext: resourceRedashVisualizationCreate,
 		UpdateContext: resourceRedashVisualizationUpdate,
 		DeleteContext: resourceRedashVisualizationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			// Base Data
 			"visualization_id": {
@@ -41,6 +50,13 @@ func resourceRedashVisualization() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"options_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    lo.Values(visualizationOptionsKeys),
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: optionsJSONDiffSuppressFunc(visualizationOptionsJSONDefaults),
+			},
 			"table_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -49,17 +65,24 @@ func resourceRedashVisualization() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"items_per_page": {
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
+							Default:  25,
+						},
+						"columns_from_query": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
 						},
 						"columns": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									// General
 									"visible": {
 										Type:     schema.TypeBool,
-										Required: true,
+										Optional: true,
+										Default:  true,
 									},
 									"name": {
 										Type:     schema.TypeString,
@@ -67,52 +90,65 @@ func resourceRedashVisualization() *schema.Resource {
 									},
 									"title": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Computed: true,
 									},
 									// Type
 									"type": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Computed: true,
 									},
 									"display_as": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice([]string{"string", "number", "datetime", "boolean", "json", "html", "image", "link"}, false),
 									},
 									"align_content": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice([]string{"left", "center", "right"}, false),
 									},
 									"allow_search": {
 										Type:     schema.TypeBool,
-										Required: true,
+										Optional: true,
+										Default:  false,
 									},
 									"order": {
 										Type:     schema.TypeInt,
-										Required: true,
+										Optional: true,
+										Computed: true,
 									},
 									// Text
 									"allow_html": {
 										Type:     schema.TypeBool,
-										Required: true,
+										Optional: true,
+										Default:  false,
 									},
 									"highlight_links": {
 										Type:     schema.TypeBool,
-										Required: true,
+										Optional: true,
+										Default:  false,
 									},
 									// Number
 									"number_format": {
 										Type:     schema.TypeString,
 										Optional: true,
+										Computed: true,
 									},
 									// Date/Time
 									"date_time_format": {
 										Type:     schema.TypeString,
 										Optional: true,
+										Computed: true,
 									},
 									// Boolean
 									"boolean_values": {
 										Type:     schema.TypeList,
-										Required: true,
+										Optional: true,
+										Computed: true,
 										Elem: &schema.Schema{
 											Type: schema.TypeString,
 										},
@@ -120,36 +156,44 @@ func resourceRedashVisualization() *schema.Resource {
 									// Link
 									"link_url_template": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Default:  "{{ @ }}",
 									},
 									"link_text_template": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Default:  "{{ @ }}",
 									},
 									"link_open_in_new_tab": {
 										Type:     schema.TypeBool,
-										Required: true,
+										Optional: true,
+										Default:  true,
 									},
 									"link_title_template": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Default:  "{{ @ }}",
 									},
 									// Image
 									"image_url_template": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Default:  "{{ @ }}",
 									},
 									"image_title_template": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Default:  "{{ @ }}",
 									},
 									"image_width": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Default:  "",
 									},
 									"image_height": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Default:  "",
 									},
 								},
 							},
@@ -187,6 +231,7 @@ func resourceRedashVisualization() *schema.Resource {
 						"error_y": {
 							Type:     schema.TypeList,
 							Optional: true,
+							Computed: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -211,6 +256,18 @@ func resourceRedashVisualization() *schema.Resource {
 										Type:     schema.TypeBool,
 										Required: true,
 									},
+									"placement": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "auto",
+										ValidateFunc: validation.StringInSlice([]string{"auto", "below"}, false),
+									},
+									"traceorder": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "normal",
+										ValidateFunc: validation.StringInSlice([]string{"normal", "reversed"}, false),
+									},
 								},
 							},
 						},
@@ -227,6 +284,7 @@ func resourceRedashVisualization() *schema.Resource {
 									"error_y": {
 										Type:     schema.TypeList,
 										Optional: true,
+										Computed: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
@@ -259,9 +317,14 @@ func resourceRedashVisualization() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
 									"labels": {
 										Type:     schema.TypeList,
 										Optional: true,
+										Computed: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
@@ -289,6 +352,20 @@ func resourceRedashVisualization() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"min": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: visualizationValidateNumber,
+									},
+									"max": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: visualizationValidateNumber,
+									},
 									"opposite": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -306,6 +383,14 @@ func resourceRedashVisualization() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"color": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
 									"z_index": {
 										Type:     schema.TypeInt,
 										Required: true,
@@ -325,6 +410,23 @@ func resourceRedashVisualization() *schema.Resource {
 								},
 							},
 						},
+						// Colors
+						"values_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"color": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
 						// Data Labels
 						"show_data_labels": {
 							Type:     schema.TypeBool,
@@ -349,180 +451,651 @@ func resourceRedashVisualization() *schema.Resource {
 					},
 				},
 			},
-		},
-	}
-}
-
-func resourceRedashVisualizationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*redash.Client)
-
-	var diags diag.Diagnostics
-
-	queryID := d.Get("query_id").(int)
-	visualizationID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	visualization, err := c.GetVisualization(queryID, visualizationID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	// TODO: fix map/array with key transformation bugs which prevent updates
-	// Base Data
-	_ = d.Set("visualization_id", visualization.ID)
-	_ = d.Set("name", visualization.Name)
-	_ = d.Set("description", visualization.Description)
-	// Options
-	_ = d.Set("type", visualization.Type)
-	if visualization.Type == "TABLE" {
-		_ = d.Set("table_options", visualization.Options)
-	} else if visualization.Type == "CHART" {
-		_ = d.Set("chart_options", visualization.Options)
-	}
-
-	return diags
-}
-
-func resourceRedashVisualizationCreate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*redash.Client)
-
-	var diags diag.Diagnostics
-
-	var vOptions interface{}
-	vType := d.Get("type").(string)
-	switch vType {
-	case "TABLE":
-		tableOptions := d.Get("table_options").([]interface{})[0].(map[string]interface{})
-		tableColumns := tableOptions["columns"].([]interface{})
-		vOptions = redash.TableOptions{
-			ItemsPerPage: tableOptions["items_per_page"].(int),
-			Columns: lo.Map(tableColumns, func(item interface{}, _ int) redash.TableColumn {
-				column := item.(map[string]interface{})
-				return redash.TableColumn{
-					// Shared
-					Visible: column["visible"].(bool),
-					Name:    column["name"].(string),
-					Title:   column["title"].(string),
-					// Type
-					Type:         column["type"].(string),
-					DisplayAs:    column["display_as"].(string),
-					AlignContent: column["align_content"].(string),
-					AllowSearch:  column["allow_search"].(bool),
-					Order:        column["order"].(int),
-					// Text
-					AllowHTML:      column["allow_html"].(bool),
-					HighlightLinks: column["highlight_links"].(bool),
-					// Number
-					NumberFormat: column["number_format"].(string),
-					// Date/Time
-					DateTimeFormat: column["date_time_format"].(string),
-					// Boolean
-					BooleanValues: lo.Map(column["boolean_values"].([]interface{}), func(item interface{}, _ int) string {
-						return item.(string)
-					}),
-					// Link
-					LinkUrlTemplate:   column["link_url_template"].(string),
-					LinkTextTemplate:  column["link_text_template"].(string),
-					LinkOpenInNewTab:  column["link_open_in_new_tab"].(bool),
-					LinkTitleTemplate: column["link_title_template"].(string),
-					// Image
-					ImageUrlTemplate:   column["image_url_template"].(string),
-					ImageTitleTemplate: column["image_title_template"].(string),
-					ImageWidth:         column["image_width"].(string),
-					ImageHeight:        column["image_height"].(string),
-				}
-			}),
-		}
-		break
-	case "CHART":
-		chartOptions := d.Get("chart_options").([]interface{})[0].(map[string]interface{})
-
-		var chartLegend map[string]interface{}
-		dChartLegend := chartOptions["legend"].([]interface{})[0]
-		if dChartLegend != nil {
-			chartLegend = dChartLegend.(map[string]interface{})
-		}
-
-		var chartSeries map[string]interface{}
-		dChartSeries := chartOptions["series"].([]interface{})[0]
-		if dChartSeries != nil {
-			chartSeries = dChartSeries.(map[string]interface{})
-		}
-
-		var chartXAxis map[string]interface{}
-		dChartXAxis := chartOptions["x_axis"].([]interface{})[0]
-		if dChartXAxis != nil {
-			chartXAxis = dChartXAxis.(map[string]interface{})
-		}
-
-		chartYAxis := chartOptions["y_axis"].([]interface{})
-		chartSeriesOptions := chartOptions["series_options"].([]interface{})
-		vOptions = redash.ChartOptions{
-			// General
-			GlobalSeriesType: chartOptions["global_series_type"].(string),
-			ColumnMapping: lo.Associate(
-				chartOptions["column_mapping"].([]interface{}),
-				func(item interface{}) (string, string) {
-					axis := item.(map[string]interface{})["axis"].(string)
-					column := item.(map[string]interface{})["column"].(string)
-					return axis, column
+			"counter_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Value
+						"counter_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"counter_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "counter",
+						},
+						"row_number": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"count_rows": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						// Target
+						"target_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"target_row_number": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						// Formatting
+						"string_decimal": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"string_decimal_char": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  ".",
+						},
+						"string_thousand_separator": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  ",",
+						},
+						"string_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"string_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"format_target_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"tooltip_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0,0.000",
+						},
+					},
 				},
-			),
-			Legend: redash.ChartLegend{
-				Enabled: chartLegend["enabled"].(bool),
-				// Placement: chartLegend["placement"].(string),
 			},
-			Series: redash.ChartSeries{
-				Stacking: lo.TernaryF(
-					chartSeries["stacking"] != nil,
-					func() *string { return lo.EmptyableToPtr(chartSeries["stacking"].(string)) },
-					func() *string { return nil },
-				),
+			"pivot_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rows": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"aggregator_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Count",
+						},
+						"renderer_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Table",
+						},
+						// Display
+						"hide_controls": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"show_row_totals": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"show_column_totals": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
 			},
-			MissingValuesAsZero: chartOptions["missing_values_as_zero"].(bool),
-			// CHART TYPE - X-Axis
-			XAxis: redash.ChartXAxis{
-				Type: chartXAxis["type"].(string),
-				Labels: struct {
-					Enabled bool `json:"enabled"`
-				}{
-					Enabled: chartXAxis["labels"].([]interface{})[0].(map[string]interface{})["enabled"].(bool),
+			"details_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "",
+									},
+									"visible": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"order": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  0,
+									},
+									"display_as": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "string",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
-			SortX: chartOptions["sort_x"].(bool),
-			// CHART TYPE - Y-Axis
-			YAxis: lo.Map(chartYAxis, func(item interface{}, _ int) redash.ChartYAxis {
-				yAxis := item.(map[string]interface{})
-
-				return redash.ChartYAxis{
-					Type:     yAxis["type"].(string),
-					Opposite: yAxis["opposite"].(bool),
-				}
-			}),
-			// CHART TYPE - Series
-			SeriesOptions: lo.Associate(chartSeriesOptions, func(value interface{}) (string, redash.ChartSeriesOption) {
-				seriesOption := value.(map[string]interface{})
+			"cohort_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "daily",
+							ValidateFunc: validation.StringInSlice([]string{"daily", "weekly", "monthly"}, false),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "diagonal",
+							ValidateFunc: validation.StringInSlice([]string{"diagonal", "simple"}, false),
+						},
+						// Columns
+						"date_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "date",
+						},
+						"stage_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "day_number",
+						},
+						"total_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "total",
+						},
+						"value_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "value",
+						},
+						// Appearance
+						"number_values_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0,0[.]00",
+						},
+						"percent_values_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0.00%",
+						},
+						"no_value_placeholder": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "-",
+						},
+						"show_tooltips": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"funnel_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Columns
+						"step_column": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"step_display_as": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Steps",
+						},
+						"value_column": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value_display_as": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Value",
+						},
+						// Sorting
+						"auto_sort": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"sort_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"sort_reverse": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"items_limit": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  100,
+						},
+						// Appearance
+						"percent_values_range_min": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Default:  0.01,
+						},
+						"percent_values_range_max": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Default:  1000.0,
+						},
+						"number_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0,0[.]00",
+						},
+						"percent_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0[.]00%",
+						},
+					},
+				},
+			},
+			"word_cloud_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"frequencies_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						// Limits (0 means no limit)
+						"word_length_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"word_length_max": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"word_count_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"word_count_max": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+			"boxplot_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"x_axis_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"y_axis_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			"map_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Columns
+						"latitude_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "lat",
+						},
+						"longitude_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "lon",
+						},
+						"group_by_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						// Style
+						"map_tile_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "//{s}.tile.openstreetmap.org/{z}/{x}/{y}.png",
+						},
+						"cluster_markers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"customize_markers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"icon_shape": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "marker",
+						},
+						"icon_font": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "circle",
+						},
+						"foreground_color": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#ffffff",
+						},
+						"background_color": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#356AFF",
+						},
+						"border_color": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#356AFF",
+						},
+						// Format
+						"tooltip_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"tooltip_template": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"popup_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"popup_template": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			"choropleth_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// General
+						"map_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "countries",
+						},
+						"key_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"target_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"value_column": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						// Colors
+						"clustering_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "e",
+							ValidateFunc: validation.StringInSlice([]string{"q", "e", "k"}, false),
+						},
+						"steps": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  5,
+						},
+						"color_min": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#799CFF",
+						},
+						"color_max": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#002FB4",
+						},
+						"color_no_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#dddddd",
+						},
+						"color_background": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#ffffff",
+						},
+						"color_borders": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#ffffff",
+						},
+						// Format
+						"value_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0,0.00",
+						},
+						"no_value_placeholder": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "N/A",
+						},
+						"legend_visible": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"legend_position": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "bottom-left",
+						},
+						"legend_align_text": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "right",
+						},
+						"tooltip_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"tooltip_template": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "<b>{{ @@name }}</b>: {{ @@value }}",
+						},
+						"popup_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"popup_template": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "<b>{{ @@name }}</b>: {{ @@value }}",
+						},
+					},
+				},
+			},
+		},
+	}
+}
 
-				return seriesOption["name"].(string), redash.ChartSeriesOption{
-					ZIndex: seriesOption["z_index"].(int),
-					Index:  seriesOption["index"].(int),
-					Type:   seriesOption["type"].(string),
-					YAxis:  seriesOption["y_axis"].(int),
-				}
-			}),
-			// CHART TYPE - Colors
-			// CHART TYPE - Data Labels
-			ShowDataLabels: chartOptions["show_data_labels"].(bool),
-			NumberFormat:   chartOptions["number_format"].(string),
-			PercentFormat:  chartOptions["percent_format"].(string),
-			DateTimeFormat: chartOptions["date_time_format"].(string),
-			TextFormat:     chartOptions["text_format"].(string),
+func resourceRedashVisualizationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	queryID := d.Get("query_id").(int)
+	visualizationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	visualization, err := c.GetVisualization(queryID, visualizationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// TODO: fix map/array with key transformation bugs which prevent updates
+	// Base Data
+	_ = d.Set("visualization_id", visualization.ID)
+	_ = d.Set("name", visualization.Name)
+	_ = d.Set("description", visualization.Description)
+	// Options
+	_ = d.Set("type", visualization.Type)
+	if d.Get("options_json").(string) != "" {
+		optionsJSON, err := json.Marshal(visualization.Options)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		break
-	default:
-		return diag.Errorf("Invalid visualization type: %s", vType)
+		_ = d.Set("options_json", string(optionsJSON))
+	} else if key, ok := visualizationOptionsKeys[visualization.Type]; ok {
+		options, err := visualizationFlattenOptions(visualization.Type, visualization.Options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		prior := map[string]interface{}{}
+		if priorOptions := d.Get(key).([]interface{}); len(priorOptions) > 0 && priorOptions[0] != nil {
+			prior = priorOptions[0].(map[string]interface{})
+		}
+
+		// Columns generated from the query result are only tracked when they
+		// are configured explicitly
+		if visualization.Type == "TABLE" && prior["columns_from_query"] == true {
+			priorColumns, _ := prior["columns"].([]interface{})
+			priorNames := lo.Map(priorColumns, func(item interface{}, _ int) interface{} {
+				return item.(map[string]interface{})["name"]
+			})
+			columns := lo.Filter(options[0]["columns"].([]map[string]interface{}), func(column map[string]interface{}, _ int) bool {
+				return lo.Contains(priorNames, column["name"])
+			})
+
+			options[0]["columns_from_query"] = true
+			options[0]["columns"] = visualizationOrderLike(columns, priorColumns, "name")
+		}
+
+		// Keep map based chart options in the order they were configured in
+		if visualization.Type == "CHART" {
+			for listKey, itemKey := range map[string]string{"column_mapping": "column", "series_options": "name", "values_options": "value"} {
+				priorItems, _ := prior[listKey].([]interface{})
+				options[0][listKey] = visualizationOrderLike(options[0][listKey].([]map[string]interface{}), priorItems, itemKey)
+			}
+		}
+		_ = d.Set(key, options)
+	}
+
+	return diags
+}
+
+func resourceRedashVisualizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	vOptions, err := resourceRedashVisualizationOptions(ctx, d, c)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	payload := redash.VisualizationCreatePayload{
@@ -546,7 +1119,7 @@ func resourceRedashVisualizationCreate(_ context.Context, d *schema.ResourceData
 	return diags
 }
 
-func resourceRedashVisualizationUpdate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRedashVisualizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*redash.Client)
 
 	var diags diag.Diagnostics
@@ -556,173 +1129,1226 @@ func resourceRedashVisualizationUpdate(_ context.Context, d *schema.ResourceData
 		diag.FromErr(err)
 	}
 
-	var vOptions interface{}
+	vOptions, err := resourceRedashVisualizationOptions(ctx, d, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := redash.VisualizationUpdatePayload{
+		// Base Data
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		// Options
+		Type:    d.Get("type").(string),
+		Options: vOptions,
+	}
+	_, err = c.UpdateVisualization(id, &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceRedashVisualizationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*redash.Client)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteVisualization(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("")
+
+	return diags
+}
+
+// resourceRedashVisualizationOptions builds the API options of the
+// visualization from the typed options block matching its type
+func resourceRedashVisualizationOptions(ctx context.Context, d *schema.ResourceData, c *redash.Client) (interface{}, error) {
+	if optionsJSON, ok := d.GetOk("options_json"); ok {
+		return optionsJSONExpand(optionsJSON.(string))
+	}
+
 	vType := d.Get("type").(string)
 	switch vType {
 	case "TABLE":
-		tableOptions := d.Get("table_options").([]interface{})[0].(map[string]interface{})
-		tableColumns := tableOptions["columns"].([]interface{})
-		vOptions = redash.TableOptions{
-			ItemsPerPage: tableOptions["items_per_page"].(int),
-			Columns: lo.Map(tableColumns, func(item interface{}, _ int) redash.TableColumn {
-				column := item.(map[string]interface{})
-				return redash.TableColumn{
-					// Shared
-					Visible: column["visible"].(bool),
-					Name:    column["name"].(string),
-					Title:   column["title"].(string),
-					// Type
-					Type:         column["type"].(string),
-					DisplayAs:    column["display_as"].(string),
-					AlignContent: column["align_content"].(string),
-					AllowSearch:  column["allow_search"].(bool),
-					Order:        column["order"].(int),
-					// Text
-					AllowHTML:      column["allow_html"].(bool),
-					HighlightLinks: column["highlight_links"].(bool),
-					// Number
-					NumberFormat: column["number_format"].(string),
-					// Date/Time
-					DateTimeFormat: column["date_time_format"].(string),
-					// Boolean
-					BooleanValues: lo.Map(column["boolean_values"].([]interface{}), func(item interface{}, _ int) string {
-						return item.(string)
-					}),
-					// Link
-					LinkUrlTemplate:   column["link_url_template"].(string),
-					LinkTextTemplate:  column["link_text_template"].(string),
-					LinkOpenInNewTab:  column["link_open_in_new_tab"].(bool),
-					LinkTitleTemplate: column["link_title_template"].(string),
-					// Image
-					ImageUrlTemplate:   column["image_url_template"].(string),
-					ImageTitleTemplate: column["image_title_template"].(string),
-					ImageWidth:         column["image_width"].(string),
-					ImageHeight:        column["image_height"].(string),
-				}
-			}),
+		tableOptions, err := resourceRedashVisualizationOptionsBlock(d, "table_options")
+		if err != nil {
+			return nil, err
 		}
-		break
-	case "CHART":
-		chartOptions := d.Get("chart_options").([]interface{})[0].(map[string]interface{})
 
-		var chartLegend map[string]interface{}
-		dChartLegend := chartOptions["legend"].([]interface{})[0]
-		if dChartLegend != nil {
-			chartLegend = dChartLegend.(map[string]interface{})
-		}
+		tableColumns := lo.Map(tableOptions["columns"].([]interface{}), func(item interface{}, _ int) map[string]interface{} {
+			return item.(map[string]interface{})
+		})
 
-		var chartSeries map[string]interface{}
-		dChartSeries := chartOptions["series"].([]interface{})[0]
-		if dChartSeries != nil {
-			chartSeries = dChartSeries.(map[string]interface{})
+		var resultColumns []QueryResultColumn
+		if tableOptions["columns_from_query"].(bool) {
+			resultColumns, err = visualizationQueryResultColumns(ctx, c, d.Get("query_id").(int))
+			if err != nil {
+				return nil, err
+			}
+		} else if len(tableColumns) == 0 {
+			return nil, fmt.Errorf("table_options must set columns unless columns_from_query is enabled")
 		}
 
-		var chartXAxis map[string]interface{}
-		dChartXAxis := chartOptions["x_axis"].([]interface{})[0]
-		if dChartXAxis != nil {
-			chartXAxis = dChartXAxis.(map[string]interface{})
+		return redash.TableOptions{
+			ItemsPerPage: tableOptions["items_per_page"].(int),
+			Columns:      visualizationTableColumns(tableColumns, resultColumns),
+		}, nil
+	case "CHART":
+		chartOptions, err := resourceRedashVisualizationOptionsBlock(d, "chart_options")
+		if err != nil {
+			return nil, err
 		}
 
-		chartYAxis := chartOptions["y_axis"].([]interface{})
-		chartSeriesOptions := chartOptions["series_options"].([]interface{})
-		vOptions = redash.ChartOptions{
+		chartLegend := resourceRedashVisualizationNestedBlock(chartOptions, "legend")
+		chartSeries := resourceRedashVisualizationNestedBlock(chartOptions, "series")
+		chartXAxis := resourceRedashVisualizationNestedBlock(chartOptions, "x_axis")
+		chartStacking, _ := chartSeries["stacking"].(string)
+
+		options := ChartOptions{
 			// General
 			GlobalSeriesType: chartOptions["global_series_type"].(string),
 			ColumnMapping: lo.Associate(
 				chartOptions["column_mapping"].([]interface{}),
 				func(item interface{}) (string, string) {
-					axis := item.(map[string]interface{})["axis"].(string)
 					column := item.(map[string]interface{})["column"].(string)
-					return axis, column
+					axis := item.(map[string]interface{})["axis"].(string)
+					return column, axis
 				},
 			),
-			Legend: redash.ChartLegend{
-				Enabled: chartLegend["enabled"].(bool),
-				// Placement: chartLegend["placement"].(string),
+			ErrorY: resourceRedashVisualizationErrorY(resourceRedashVisualizationNestedBlock(chartOptions, "error_y")),
+			Legend: ChartLegend{
+				Enabled:    chartLegend["enabled"].(bool),
+				Placement:  chartLegend["placement"].(string),
+				TraceOrder: chartLegend["traceorder"].(string),
 			},
-			Series: redash.ChartSeries{
-				Stacking: lo.TernaryF(
-					chartSeries["stacking"] != nil,
-					func() *string { return lo.EmptyableToPtr(chartSeries["stacking"].(string)) },
-					func() *string { return nil },
-				),
+			Series: ChartSeries{
+				Stacking: lo.EmptyableToPtr(chartStacking),
+				ErrorY:   resourceRedashVisualizationErrorY(resourceRedashVisualizationNestedBlock(chartSeries, "error_y")),
 			},
 			MissingValuesAsZero: chartOptions["missing_values_as_zero"].(bool),
-			// CHART TYPE - X-Axis
-			XAxis: redash.ChartXAxis{
+			// X-Axis
+			XAxis: ChartXAxis{
 				Type: chartXAxis["type"].(string),
-				Labels: struct {
-					Enabled bool `json:"enabled"`
-				}{
-					Enabled: chartXAxis["labels"].([]interface{})[0].(map[string]interface{})["enabled"].(bool),
+				Title: ChartAxisTitle{
+					Text: chartXAxis["title"].(string),
 				},
 			},
 			SortX: chartOptions["sort_x"].(bool),
-			// CHART TYPE - Y-Axis
-			YAxis: lo.Map(chartYAxis, func(item interface{}, _ int) redash.ChartYAxis {
+			// Y-Axis
+			YAxis: lo.Map(chartOptions["y_axis"].([]interface{}), func(item interface{}, _ int) ChartYAxis {
 				yAxis := item.(map[string]interface{})
 
-				return redash.ChartYAxis{
-					Type:     yAxis["type"].(string),
+				return ChartYAxis{
+					Type: yAxis["type"].(string),
+					Title: ChartAxisTitle{
+						Text: yAxis["title"].(string),
+					},
+					RangeMin: visualizationParseNumber(yAxis["min"].(string)),
+					RangeMax: visualizationParseNumber(yAxis["max"].(string)),
 					Opposite: yAxis["opposite"].(bool),
 				}
 			}),
-			// CHART TYPE - Series
-			SeriesOptions: lo.Associate(chartSeriesOptions, func(value interface{}) (string, redash.ChartSeriesOption) {
+			// Series
+			SeriesOptions: lo.Associate(chartOptions["series_options"].([]interface{}), func(value interface{}) (string, ChartSeriesOption) {
 				seriesOption := value.(map[string]interface{})
 
-				return seriesOption["name"].(string), redash.ChartSeriesOption{
+				return seriesOption["name"].(string), ChartSeriesOption{
+					Name:   seriesOption["display_name"].(string),
+					Color:  seriesOption["color"].(string),
 					ZIndex: seriesOption["z_index"].(int),
 					Index:  seriesOption["index"].(int),
 					Type:   seriesOption["type"].(string),
 					YAxis:  seriesOption["y_axis"].(int),
 				}
 			}),
-			// CHART TYPE - Colors
-			// CHART TYPE - Data Labels
+			// Colors
+			ValuesOptions: lo.Associate(chartOptions["values_options"].([]interface{}), func(value interface{}) (string, ChartValueOption) {
+				valueOption := value.(map[string]interface{})
+
+				return valueOption["value"].(string), ChartValueOption{
+					Color: valueOption["color"].(string),
+				}
+			}),
+			// Data Labels
 			ShowDataLabels: chartOptions["show_data_labels"].(bool),
 			NumberFormat:   chartOptions["number_format"].(string),
 			PercentFormat:  chartOptions["percent_format"].(string),
 			DateTimeFormat: chartOptions["date_time_format"].(string),
 			TextFormat:     chartOptions["text_format"].(string),
 		}
-		break
+		options.XAxis.Labels.Enabled = lo.ValueOr(resourceRedashVisualizationNestedBlock(chartXAxis, "labels"), "enabled", true).(bool)
+		return options, nil
+	case "COUNTER":
+		counterOptions, err := resourceRedashVisualizationOptionsBlock(d, "counter_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return CounterOptions{
+			// Value
+			CounterLabel:   counterOptions["counter_label"].(string),
+			CounterColName: counterOptions["counter_column"].(string),
+			RowNumber:      counterOptions["row_number"].(int),
+			CountRow:       counterOptions["count_rows"].(bool),
+			// Target
+			TargetColName:   counterOptions["target_column"].(string),
+			TargetRowNumber: counterOptions["target_row_number"].(int),
+			// Formatting
+			StringDecimal:     counterOptions["string_decimal"].(int),
+			StringDecChar:     counterOptions["string_decimal_char"].(string),
+			StringThouSep:     counterOptions["string_thousand_separator"].(string),
+			StringPrefix:      counterOptions["string_prefix"].(string),
+			StringSuffix:      counterOptions["string_suffix"].(string),
+			FormatTargetValue: counterOptions["format_target_value"].(bool),
+			TooltipFormat:     counterOptions["tooltip_format"].(string),
+		}, nil
+	case "PIVOT":
+		pivotOptions, err := resourceRedashVisualizationOptionsBlock(d, "pivot_options")
+		if err != nil {
+			return nil, err
+		}
+
+		options := PivotOptions{
+			Rows:           resourceRedashVisualizationStrings(pivotOptions["rows"]),
+			Cols:           resourceRedashVisualizationStrings(pivotOptions["columns"]),
+			Vals:           resourceRedashVisualizationStrings(pivotOptions["values"]),
+			AggregatorName: pivotOptions["aggregator_name"].(string),
+			RendererName:   pivotOptions["renderer_name"].(string),
+			// Display
+			Controls: PivotControls{
+				Enabled: pivotOptions["hide_controls"].(bool),
+			},
+		}
+		options.RendererOptions.Table.RowTotals = pivotOptions["show_row_totals"].(bool)
+		options.RendererOptions.Table.ColTotals = pivotOptions["show_column_totals"].(bool)
+		return options, nil
+	case "DETAILS":
+		detailsOptions, err := resourceRedashVisualizationOptionsBlock(d, "details_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return DetailsOptions{
+			Columns: lo.Map(detailsOptions["columns"].([]interface{}), func(item interface{}, _ int) DetailsColumn {
+				column := item.(map[string]interface{})
+				return DetailsColumn{
+					Name:      column["name"].(string),
+					Title:     column["title"].(string),
+					Visible:   column["visible"].(bool),
+					Order:     column["order"].(int),
+					DisplayAs: column["display_as"].(string),
+				}
+			}),
+		}, nil
+	case "COHORT":
+		cohortOptions, err := resourceRedashVisualizationOptionsBlock(d, "cohort_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return CohortOptions{
+			TimeInterval: cohortOptions["time_interval"].(string),
+			Mode:         cohortOptions["mode"].(string),
+			// Columns
+			DateColumn:  cohortOptions["date_column"].(string),
+			StageColumn: cohortOptions["stage_column"].(string),
+			TotalColumn: cohortOptions["total_column"].(string),
+			ValueColumn: cohortOptions["value_column"].(string),
+			// Appearance
+			NumberValuesFormat:  cohortOptions["number_values_format"].(string),
+			PercentValuesFormat: cohortOptions["percent_values_format"].(string),
+			NoValuePlaceholder:  cohortOptions["no_value_placeholder"].(string),
+			ShowTooltips:        cohortOptions["show_tooltips"].(bool),
+		}, nil
+	case "FUNNEL":
+		funnelOptions, err := resourceRedashVisualizationOptionsBlock(d, "funnel_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return FunnelOptions{
+			// Columns
+			StepCol: FunnelColumn{
+				ColName:   lo.EmptyableToPtr(funnelOptions["step_column"].(string)),
+				DisplayAs: funnelOptions["step_display_as"].(string),
+			},
+			ValueCol: FunnelColumn{
+				ColName:   lo.EmptyableToPtr(funnelOptions["value_column"].(string)),
+				DisplayAs: funnelOptions["value_display_as"].(string),
+			},
+			// Sorting
+			AutoSort: funnelOptions["auto_sort"].(bool),
+			SortKeyCol: FunnelSortColumn{
+				ColName: lo.EmptyableToPtr(funnelOptions["sort_column"].(string)),
+				Reverse: funnelOptions["sort_reverse"].(bool),
+			},
+			ItemsLimit: funnelOptions["items_limit"].(int),
+			// Appearance
+			PercentValuesRange: FunnelValuesRange{
+				Min: funnelOptions["percent_values_range_min"].(float64),
+				Max: funnelOptions["percent_values_range_max"].(float64),
+			},
+			NumberFormat:  funnelOptions["number_format"].(string),
+			PercentFormat: funnelOptions["percent_format"].(string),
+		}, nil
+	case "WORD_CLOUD":
+		wordCloudOptions, err := resourceRedashVisualizationOptionsBlock(d, "word_cloud_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return WordCloudOptions{
+			Column:            lo.EmptyableToPtr(wordCloudOptions["column"].(string)),
+			FrequenciesColumn: lo.EmptyableToPtr(wordCloudOptions["frequencies_column"].(string)),
+			// Limits
+			WordLengthLimit: WordCloudLimit{
+				Min: lo.EmptyableToPtr(wordCloudOptions["word_length_min"].(int)),
+				Max: lo.EmptyableToPtr(wordCloudOptions["word_length_max"].(int)),
+			},
+			WordCountLimit: WordCloudLimit{
+				Min: lo.EmptyableToPtr(wordCloudOptions["word_count_min"].(int)),
+				Max: lo.EmptyableToPtr(wordCloudOptions["word_count_max"].(int)),
+			},
+		}, nil
+	case "BOXPLOT":
+		boxplotOptions, err := resourceRedashVisualizationOptionsBlock(d, "boxplot_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return BoxplotOptions{
+			XAxisLabel: boxplotOptions["x_axis_label"].(string),
+			YAxisLabel: boxplotOptions["y_axis_label"].(string),
+		}, nil
+	case "MAP":
+		mapOptions, err := resourceRedashVisualizationOptionsBlock(d, "map_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return MapOptions{
+			// Columns
+			LatColName: mapOptions["latitude_column"].(string),
+			LonColName: mapOptions["longitude_column"].(string),
+			Classify:   lo.EmptyableToPtr(mapOptions["group_by_column"].(string)),
+			// Style
+			MapTileUrl:       mapOptions["map_tile_url"].(string),
+			ClusterMarkers:   mapOptions["cluster_markers"].(bool),
+			CustomizeMarkers: mapOptions["customize_markers"].(bool),
+			IconShape:        mapOptions["icon_shape"].(string),
+			IconFont:         mapOptions["icon_font"].(string),
+			ForegroundColor:  mapOptions["foreground_color"].(string),
+			BackgroundColor:  mapOptions["background_color"].(string),
+			BorderColor:      mapOptions["border_color"].(string),
+			// Format
+			Tooltip: MapTemplateItem{
+				Enabled:  mapOptions["tooltip_enabled"].(bool),
+				Template: mapOptions["tooltip_template"].(string),
+			},
+			Popup: MapTemplateItem{
+				Enabled:  mapOptions["popup_enabled"].(bool),
+				Template: mapOptions["popup_template"].(string),
+			},
+		}, nil
+	case "CHOROPLETH":
+		choroplethOptions, err := resourceRedashVisualizationOptionsBlock(d, "choropleth_options")
+		if err != nil {
+			return nil, err
+		}
+
+		return ChoroplethOptions{
+			// General
+			MapType:     choroplethOptions["map_type"].(string),
+			KeyColumn:   lo.EmptyableToPtr(choroplethOptions["key_column"].(string)),
+			TargetField: lo.EmptyableToPtr(choroplethOptions["target_field"].(string)),
+			ValueColumn: lo.EmptyableToPtr(choroplethOptions["value_column"].(string)),
+			// Colors
+			ClusteringMode: choroplethOptions["clustering_mode"].(string),
+			Steps:          choroplethOptions["steps"].(int),
+			Colors: ChoroplethColors{
+				Min:        choroplethOptions["color_min"].(string),
+				Max:        choroplethOptions["color_max"].(string),
+				NoValue:    choroplethOptions["color_no_value"].(string),
+				Background: choroplethOptions["color_background"].(string),
+				Borders:    choroplethOptions["color_borders"].(string),
+			},
+			// Format
+			ValueFormat:        choroplethOptions["value_format"].(string),
+			NoValuePlaceholder: choroplethOptions["no_value_placeholder"].(string),
+			Legend: ChoroplethLegend{
+				Visible:   choroplethOptions["legend_visible"].(bool),
+				Position:  choroplethOptions["legend_position"].(string),
+				AlignText: choroplethOptions["legend_align_text"].(string),
+			},
+			Tooltip: MapTemplateItem{
+				Enabled:  choroplethOptions["tooltip_enabled"].(bool),
+				Template: choroplethOptions["tooltip_template"].(string),
+			},
+			Popup: MapTemplateItem{
+				Enabled:  choroplethOptions["popup_enabled"].(bool),
+				Template: choroplethOptions["popup_template"].(string),
+			},
+		}, nil
+	case "SANKEY", "SUNBURST_SEQUENCE":
+		// These visualizations are configured entirely by the query result
+		// columns and have no options
+		return map[string]interface{}{}, nil
 	default:
-		return diag.Errorf("Invalid visualization type: %s", vType)
+		// Includes TIMELINE, which is not a stock Redash visualization
+		return nil, fmt.Errorf("Invalid visualization type: %s, visualizations without an options block must set options_json", vType)
 	}
 
-	payload := redash.VisualizationUpdatePayload{
-		// Base Data
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
-		// Options
-		Type:    d.Get("type").(string),
-		Options: vOptions,
+}
+
+// visualizationQueryResultColumns returns the columns of the result of a
+// query, executing it with the default values of its parameters unless a
+// cached result exists, so queries created in the same apply can be used
+func visualizationQueryResultColumns(ctx context.Context, c *redash.Client, queryID int) ([]QueryResultColumn, error) {
+	query, err := c.GetQuery(queryID)
+	if err != nil {
+		return nil, err
 	}
-	_, err = c.UpdateVisualization(id, &payload)
+
+	parameters := lo.SliceToMap(query.Options.Parameters, func(parameter redash.QueryOptionsParameter) (string, interface{}) {
+		return parameter.Name, parameter.Value
+	})
+
+	result, err := waitForQueryResult(ctx, c, queryID, &QueryResultPayload{
+		Parameters: parameters,
+		MaxAge:     -1,
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, fmt.Errorf("unable to get the result of query %d for columns_from_query: %w", queryID, err)
 	}
 
-	return diags
+	return result.Data.Columns, nil
 }
 
-func resourceRedashVisualizationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*redash.Client)
+// visualizationTableColumns builds the columns of a table visualization. A
+// column is generated with the Redash UI defaults for every query result column
+// and for every configured column, with the configured fields taking precedence
+func visualizationTableColumns(tableColumns []map[string]interface{}, resultColumns []QueryResultColumn) []redash.TableColumn {
+	configured := lo.SliceToMap(tableColumns, func(column map[string]interface{}) (string, map[string]interface{}) {
+		return column["name"].(string), column
+	})
 
-	var diags diag.Diagnostics
+	columns := lo.Map(resultColumns, func(resultColumn QueryResultColumn, i int) redash.TableColumn {
+		columnType := resultColumn.Type
+		if column, ok := configured[resultColumn.Name]; ok && column["type"].(string) != "" {
+			columnType = column["type"].(string)
+		}
 
-	id, err := strconv.Atoi(d.Id())
+		return visualizationApplyTableColumn(
+			visualizationDefaultTableColumn(resultColumn.Name, resultColumn.FriendlyName, columnType, i),
+			configured[resultColumn.Name],
+		)
+	})
+
+	for _, column := range tableColumns {
+		name := column["name"].(string)
+		if lo.ContainsBy(resultColumns, func(resultColumn QueryResultColumn) bool { return resultColumn.Name == name }) {
+			continue
+		}
+
+		columns = append(columns, visualizationApplyTableColumn(
+			visualizationDefaultTableColumn(name, "", column["type"].(string), len(columns)),
+			column,
+		))
+	}
+
+	return columns
+}
+
+// visualizationDefaultTableColumn returns a table column with the defaults the
+// Redash UI uses for a query result column of the given type
+func visualizationDefaultTableColumn(name string, title string, columnType string, index int) redash.TableColumn {
+	column := redash.TableColumn{
+		// Shared
+		Visible: true,
+		Name:    name,
+		Title:   lo.Ternary(title != "", title, name),
+		// Type
+		Type:         lo.Ternary(columnType != "", columnType, "string"),
+		DisplayAs:    "string",
+		AlignContent: "left",
+		AllowSearch:  false,
+		Order:        100000 + index,
+		// Boolean
+		BooleanValues: []string{"false", "true"},
+		// Link
+		LinkUrlTemplate:   "{{ @ }}",
+		LinkTextTemplate:  "{{ @ }}",
+		LinkOpenInNewTab:  true,
+		LinkTitleTemplate: "{{ @ }}",
+		// Image
+		ImageUrlTemplate:   "{{ @ }}",
+		ImageTitleTemplate: "{{ @ }}",
+	}
+
+	switch columnType {
+	case "integer":
+		column.DisplayAs = "number"
+		column.AlignContent = "right"
+		column.NumberFormat = "0,0"
+	case "float":
+		column.DisplayAs = "number"
+		column.AlignContent = "right"
+		column.NumberFormat = "0,0.00"
+	case "boolean":
+		column.DisplayAs = "boolean"
+	case "date":
+		column.DisplayAs = "datetime"
+		column.DateTimeFormat = "DD/MM/YY"
+	case "datetime":
+		column.DisplayAs = "datetime"
+		column.DateTimeFormat = "DD/MM/YY HH:mm"
+	}
+
+	return column
+}
+
+// visualizationApplyTableColumn overrides a table column with the fields set
+// in a configured columns block. Optional fields without a schema default are
+// left untouched when empty
+func visualizationApplyTableColumn(column redash.TableColumn, configured map[string]interface{}) redash.TableColumn {
+	if configured == nil {
+		return column
+	}
+
+	overrideString := func(target *string, key string) {
+		if value := configured[key].(string); value != "" {
+			*target = value
+		}
+	}
+
+	// Shared
+	column.Visible = configured["visible"].(bool)
+	overrideString(&column.Title, "title")
+	// Type
+	overrideString(&column.Type, "type")
+	overrideString(&column.DisplayAs, "display_as")
+	overrideString(&column.AlignContent, "align_content")
+	column.AllowSearch = configured["allow_search"].(bool)
+	if order := configured["order"].(int); order != 0 {
+		column.Order = order
+	}
+	// Text
+	column.AllowHTML = configured["allow_html"].(bool)
+	column.HighlightLinks = configured["highlight_links"].(bool)
+	// Number
+	overrideString(&column.NumberFormat, "number_format")
+	// Date/Time
+	overrideString(&column.DateTimeFormat, "date_time_format")
+	// Boolean
+	if booleanValues := resourceRedashVisualizationStrings(configured["boolean_values"]); len(booleanValues) > 0 {
+		column.BooleanValues = booleanValues
+	}
+	// Link
+	column.LinkUrlTemplate = configured["link_url_template"].(string)
+	column.LinkTextTemplate = configured["link_text_template"].(string)
+	column.LinkOpenInNewTab = configured["link_open_in_new_tab"].(bool)
+	column.LinkTitleTemplate = configured["link_title_template"].(string)
+	// Image
+	column.ImageUrlTemplate = configured["image_url_template"].(string)
+	column.ImageTitleTemplate = configured["image_title_template"].(string)
+	column.ImageWidth = configured["image_width"].(string)
+	column.ImageHeight = configured["image_height"].(string)
+
+	return column
+}
+
+// resourceRedashVisualizationOptionsBlock returns the typed options block with
+// the given key, failing if it has not been configured
+func resourceRedashVisualizationOptionsBlock(d *schema.ResourceData, key string) (map[string]interface{}, error) {
+	block := d.Get(key).([]interface{})
+	if len(block) == 0 {
+		return nil, fmt.Errorf("%s must be set for %s visualizations", key, d.Get("type"))
+	}
+
+	return block[0].(map[string]interface{}), nil
+}
+
+// resourceRedashVisualizationStrings converts a list of strings read from the
+// resource data into a string slice
+func resourceRedashVisualizationStrings(items interface{}) []string {
+	return lo.Map(items.([]interface{}), func(item interface{}, _ int) string {
+		return item.(string)
+	})
+}
+
+// resourceRedashVisualizationNestedBlock returns the single nested block with
+// the given key, or an empty map when it has not been configured
+func resourceRedashVisualizationNestedBlock(parent map[string]interface{}, key string) map[string]interface{} {
+	block, _ := parent[key].([]interface{})
+	if len(block) == 0 || block[0] == nil {
+		return map[string]interface{}{}
+	}
+
+	return block[0].(map[string]interface{})
+}
+
+// resourceRedashVisualizationErrorY converts an error_y block into its API
+// representation. Error bars are shown by default in the Redash UI
+func resourceRedashVisualizationErrorY(errorY map[string]interface{}) redash.ChartErrorY {
+	return redash.ChartErrorY{
+		Visible: lo.ValueOr(errorY, "visible", true).(bool),
+		Type:    lo.ValueOr(errorY, "type", "data").(string),
+	}
+}
+
+// visualizationValidateNumber validates that a string attribute is either
+// empty or a number
+func visualizationValidateNumber(value interface{}, key string) ([]string, []error) {
+	if v := value.(string); v != "" {
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return nil, []error{fmt.Errorf("%s must be a number, got %q", key, v)}
+		}
+	}
+
+	return nil, nil
+}
+
+// visualizationParseNumber parses a validated number attribute, returning nil
+// when it is empty
+func visualizationParseNumber(value string) *float64 {
+	number, err := strconv.ParseFloat(value, 64)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil
 	}
 
-	err = c.DeleteVisualization(id)
+	return &number
+}
+
+// visualizationFormatNumber formats a nullable number as a number attribute
+func visualizationFormatNumber(value *float64) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+// visualizationOrderLike sorts flattened list items by the position of the item
+// with the same key in a prior list, such as the one in the current state.
+// Items which are not in the prior list keep their order and are moved last
+func visualizationOrderLike(items []map[string]interface{}, prior []interface{}, key string) []map[string]interface{} {
+	positions := map[interface{}]int{}
+	for i, item := range prior {
+		if priorItem, ok := item.(map[string]interface{}); ok {
+			positions[priorItem[key]] = i
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return lo.ValueOr(positions, items[i][key], len(prior)) < lo.ValueOr(positions, items[j][key], len(prior))
+	})
+
+	return items
+}
+
+// visualizationDecodeOptions converts the untyped options returned by the API
+// into one of the typed visualization option structures
+func visualizationDecodeOptions(options interface{}, target interface{}) error {
+	encoded, err := json.Marshal(options)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	// d.SetId("")
+	return json.Unmarshal(encoded, target)
+}
 
-	return diags
+// visualizationFlattenTableOptions converts table options into the
+// table_options block representation
+func visualizationFlattenTableOptions(options redash.TableOptions) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"items_per_page":     options.ItemsPerPage,
+		"columns_from_query": false,
+		"columns": lo.Map(options.Columns, func(column redash.TableColumn, _ int) map[string]interface{} {
+			return map[string]interface{}{
+				// Shared
+				"visible": column.Visible,
+				"name":    column.Name,
+				"title":   column.Title,
+				// Type
+				"type":          column.Type,
+				"display_as":    column.DisplayAs,
+				"align_content": column.AlignContent,
+				"allow_search":  column.AllowSearch,
+				"order":         column.Order,
+				// Text
+				"allow_html":      column.AllowHTML,
+				"highlight_links": column.HighlightLinks,
+				// Number
+				"number_format": column.NumberFormat,
+				// Date/Time
+				"date_time_format": column.DateTimeFormat,
+				// Boolean
+				"boolean_values": column.BooleanValues,
+				// Link
+				"link_url_template":    column.LinkUrlTemplate,
+				"link_text_template":   column.LinkTextTemplate,
+				"link_open_in_new_tab": column.LinkOpenInNewTab,
+				"link_title_template":  column.LinkTitleTemplate,
+				// Image
+				"image_url_template":   column.ImageUrlTemplate,
+				"image_title_template": column.ImageTitleTemplate,
+				"image_width":          column.ImageWidth,
+				"image_height":         column.ImageHeight,
+			}
+		}),
+	}}
+}
+
+// visualizationFlattenChartOptions converts chart options into the
+// chart_options block representation. Column mappings, series options and
+// value options are maps in the API and are sorted by name to keep the output
+// stable
+func visualizationFlattenChartOptions(options ChartOptions) []map[string]interface{} {
+	columns := lo.Keys(options.ColumnMapping)
+	sort.Strings(columns)
+
+	seriesNames := lo.Keys(options.SeriesOptions)
+	sort.Strings(seriesNames)
+
+	values := lo.Keys(options.ValuesOptions)
+	sort.Strings(values)
+
+	return []map[string]interface{}{{
+		// General
+		"global_series_type": options.GlobalSeriesType,
+		"column_mapping": lo.Map(columns, func(column string, _ int) map[string]interface{} {
+			return map[string]interface{}{
+				"column": column,
+				"axis":   options.ColumnMapping[column],
+			}
+		}),
+		"error_y": []map[string]interface{}{{
+			"visible": options.ErrorY.Visible,
+			"type":    options.ErrorY.Type,
+		}},
+		"legend": []map[string]interface{}{{
+			"enabled":    options.Legend.Enabled,
+			"placement":  options.Legend.Placement,
+			"traceorder": options.Legend.TraceOrder,
+		}},
+		"series": []map[string]interface{}{{
+			"stacking": lo.FromPtr(options.Series.Stacking),
+			"error_y": []map[string]interface{}{{
+				"visible": options.Series.ErrorY.Visible,
+				"type":    options.Series.ErrorY.Type,
+			}},
+		}},
+		"missing_values_as_zero": options.MissingValuesAsZero,
+		// X-Axis
+		"x_axis": []map[string]interface{}{{
+			"type":  options.XAxis.Type,
+			"title": options.XAxis.Title.Text,
+			"labels": []map[string]interface{}{{
+				"enabled": options.XAxis.Labels.Enabled,
+			}},
+		}},
+		"sort_x": options.SortX,
+		// Y-Axis
+		"y_axis": lo.Map(options.YAxis, func(yAxis ChartYAxis, _ int) map[string]interface{} {
+			return map[string]interface{}{
+				"type":     yAxis.Type,
+				"title":    yAxis.Title.Text,
+				"min":      visualizationFormatNumber(yAxis.RangeMin),
+				"max":      visualizationFormatNumber(yAxis.RangeMax),
+				"opposite": yAxis.Opposite,
+			}
+		}),
+		// Series
+		"series_options": lo.Map(seriesNames, func(name string, _ int) map[string]interface{} {
+			seriesOption := options.SeriesOptions[name]
+			return map[string]interface{}{
+				"name":         name,
+				"display_name": seriesOption.Name,
+				"color":        seriesOption.Color,
+				"z_index":      seriesOption.ZIndex,
+				"index":        seriesOption.Index,
+				"type":         seriesOption.Type,
+				"y_axis":       seriesOption.YAxis,
+			}
+		}),
+		// Colors
+		"values_options": lo.Map(values, func(value string, _ int) map[string]interface{} {
+			return map[string]interface{}{
+				"value": value,
+				"color": options.ValuesOptions[value].Color,
+			}
+		}),
+		// Data Labels
+		"show_data_labels": options.ShowDataLabels,
+		"number_format":    options.NumberFormat,
+		"percent_format":   options.PercentFormat,
+		"date_time_format": options.DateTimeFormat,
+		"text_format":      options.TextFormat,
+	}}
+}
+
+// visualizationOptionsKeys maps each visualization type with typed options to
+// the key of its options block
+var visualizationOptionsKeys = map[string]string{
+	"TABLE":      "table_options",
+	"CHART":      "chart_options",
+	"COUNTER":    "counter_options",
+	"PIVOT":      "pivot_options",
+	"DETAILS":    "details_options",
+	"COHORT":     "cohort_options",
+	"FUNNEL":     "funnel_options",
+	"WORD_CLOUD": "word_cloud_options",
+	"BOXPLOT":    "boxplot_options",
+	"MAP":        "map_options",
+	"CHOROPLETH": "choropleth_options",
+}
+
+// visualizationOptionsDefaults holds the options Redash uses for each
+// visualization type when they are not set. They are merged into both sides of
+// an options_json comparison, so setting an option to its default is no diff
+var visualizationOptionsDefaults = map[string]map[string]interface{}{
+	"TABLE": {
+		"itemsPerPage": 25,
+	},
+	"CHART": {
+		"globalSeriesType": "column",
+		"sortX":            true,
+		"legend": map[string]interface{}{
+			"enabled":    true,
+			"placement":  "auto",
+			"traceorder": "normal",
+		},
+		"xAxis": map[string]interface{}{
+			"type":   "-",
+			"labels": map[string]interface{}{"enabled": true},
+		},
+		"error_y": map[string]interface{}{
+			"type":    "data",
+			"visible": true,
+		},
+		"series": map[string]interface{}{
+			"error_y": map[string]interface{}{
+				"type":    "data",
+				"visible": true,
+			},
+		},
+		"missingValuesAsZero": true,
+		"showDataLabels":      false,
+		"numberFormat":        "0,0[.]00000",
+		"percentFormat":       "0[.]00%",
+		"dateTimeFormat":      "DD/MM/YYYY HH:mm",
+		"textFormat":          "",
+	},
+	"COUNTER": {
+		"counterLabel":      "",
+		"counterColName":    "counter",
+		"rowNumber":         1,
+		"targetColName":     "",
+		"targetRowNumber":   1,
+		"countRow":          false,
+		"stringDecimal":     0,
+		"stringDecChar":     ".",
+		"stringThouSep":     ",",
+		"stringPrefix":      "",
+		"stringSuffix":      "",
+		"formatTargetValue": false,
+		"tooltipFormat":     "0,0.000",
+	},
+	"PIVOT": {
+		"aggregatorName": "Count",
+		"rendererName":   "Table",
+		"controls":       map[string]interface{}{"enabled": false},
+		"rendererOptions": map[string]interface{}{
+			"table": map[string]interface{}{
+				"colTotals": true,
+				"rowTotals": true,
+			},
+		},
+	},
+	"COHORT": {
+		"timeInterval":        "daily",
+		"mode":                "diagonal",
+		"dateColumn":          "date",
+		"stageColumn":         "day_number",
+		"totalColumn":         "total",
+		"valueColumn":         "value",
+		"numberValuesFormat":  "0,0[.]00",
+		"percentValuesFormat": "0.00%",
+		"noValuePlaceholder":  "-",
+		"showTooltips":        true,
+	},
+	"FUNNEL": {
+		"stepCol":            map[string]interface{}{"displayAs": "Steps"},
+		"valueCol":           map[string]interface{}{"displayAs": "Value"},
+		"autoSort":           true,
+		"sortKeyCol":         map[string]interface{}{"reverse": false},
+		"itemsLimit":         100,
+		"percentValuesRange": map[string]interface{}{"min": 0.01, "max": 1000},
+		"numberFormat":       "0,0[.]00",
+		"percentFormat":      "0[.]00%",
+	},
+	"BOXPLOT": {
+		"xAxisLabel": "",
+		"yAxisLabel": "",
+	},
+	"MAP": {
+		"latColName":       "lat",
+		"lonColName":       "lon",
+		"mapTileUrl":       "//{s}.tile.openstreetmap.org/{z}/{x}/{y}.png",
+		"clusterMarkers":   true,
+		"customizeMarkers": false,
+		"iconShape":        "marker",
+		"iconFont":         "circle",
+		"foregroundColor":  "#ffffff",
+		"backgroundColor":  "#356AFF",
+		"borderColor":      "#356AFF",
+		"tooltip":          map[string]interface{}{"enabled": false, "template": ""},
+		"popup":            map[string]interface{}{"enabled": true, "template": ""},
+	},
+	"CHOROPLETH": {
+		"mapType":            "countries",
+		"clusteringMode":     "e",
+		"steps":              5,
+		"valueFormat":        "0,0.00",
+		"noValuePlaceholder": "N/A",
+		"colors": map[string]interface{}{
+			"min":        "#799CFF",
+			"max":        "#002FB4",
+			"noValue":    "#dddddd",
+			"background": "#ffffff",
+			"borders":    "#ffffff",
+		},
+		"legend": map[string]interface{}{
+			"visible":   true,
+			"position":  "bottom-left",
+			"alignText": "right",
+		},
+		"tooltip": map[string]interface{}{
+			"enabled":  true,
+			"template": "<b>{{ @@name }}</b>: {{ @@value }}",
+		},
+	},
+}
+
+// visualizationOptionsJSONDefaults returns the option defaults of the type of
+// the visualization
+func visualizationOptionsJSONDefaults(d *schema.ResourceData) map[string]interface{} {
+	return visualizationOptionsDefaults[d.Get("type").(string)]
+}
+
+// optionsJSONExpand decodes an options_json attribute into the options sent to
+// the API, which must be a JSON object
+func optionsJSONExpand(value string) (map[string]interface{}, error) {
+	options := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(value), &options); err != nil {
+		return nil, fmt.Errorf("options_json must be a JSON object: %w", err)
+	}
+
+	return options, nil
+}
+
+// optionsJSONDiffSuppressFunc returns a DiffSuppressFunc comparing
+// options_json documents semantically, after merging the defaults returned by
+// the given function into both of them, so key order, unset options and
+// options set to their default do not produce a diff
+func optionsJSONDiffSuppressFunc(defaults func(d *schema.ResourceData) map[string]interface{}) schema.SchemaDiffSuppressFunc {
+	return func(_, old, new string, d *schema.ResourceData) bool {
+		oldNormalized, err := optionsJSONNormalize(old, defaults(d))
+		if err != nil {
+			return false
+		}
+
+		newNormalized, err := optionsJSONNormalize(new, defaults(d))
+		if err != nil {
+			return false
+		}
+
+		return oldNormalized == newNormalized
+	}
+}
+
+// optionsJSONNormalize returns a canonical encoding of an options JSON
+// document. Keys are sorted, values which Redash treats the same as an unset
+// option (null, empty lists and objects) are removed and unset options are
+// filled in from defaults. Other values such as false or "" are kept, as they
+// override options whose default is different
+func optionsJSONNormalize(value string, defaults map[string]interface{}) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	var options interface{}
+	if err := json.Unmarshal([]byte(value), &options); err != nil {
+		return "", err
+	}
+
+	merged := optionsJSONMerge(defaults, optionsJSONPrune(options))
+	normalized, err := json.Marshal(optionsJSONPrune(merged))
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
+
+// optionsJSONMerge recursively fills the object keys missing from value with
+// the matching defaults. Lists and other values are taken from value as is
+func optionsJSONMerge(defaults interface{}, value interface{}) interface{} {
+	defaultsMap, ok := defaults.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	merged := lo.Assign(defaultsMap)
+	for key, item := range valueMap {
+		merged[key] = optionsJSONMerge(defaultsMap[key], item)
+	}
+
+	return merged
+}
+
+// optionsJSONPrune recursively removes object keys holding unset values. List
+// items are kept so that their positions are preserved
+func optionsJSONPrune(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		pruned := map[string]interface{}{}
+		for key, item := range v {
+			item = optionsJSONPrune(item)
+			if !optionsJSONIsUnset(item) {
+				pruned[key] = item
+			}
+		}
+		return pruned
+	case []interface{}:
+		return lo.Map(v, func(item interface{}, _ int) interface{} {
+			return optionsJSONPrune(item)
+		})
+	default:
+		return v
+	}
+}
+
+// optionsJSONIsUnset reports whether a decoded JSON value is null or an empty
+// list or object
+func optionsJSONIsUnset(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// visualizationFlattenOptions converts the untyped options returned by the API
+// into the options block representation of the given visualization type
+func visualizationFlattenOptions(vType string, options interface{}) ([]map[string]interface{}, error) {
+	switch vType {
+	case "TABLE":
+		var tableOptions redash.TableOptions
+		if err := visualizationDecodeOptions(options, &tableOptions); err != nil {
+			return nil, err
+		}
+		return visualizationFlattenTableOptions(tableOptions), nil
+	case "CHART":
+		var chartOptions ChartOptions
+		if err := visualizationDecodeOptions(options, &chartOptions); err != nil {
+			return nil, err
+		}
+		return visualizationFlattenChartOptions(chartOptions), nil
+	case "COUNTER":
+		var counterOptions CounterOptions
+		if err := visualizationDecodeOptions(options, &counterOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			// Value
+			"counter_label":  counterOptions.CounterLabel,
+			"counter_column": counterOptions.CounterColName,
+			"row_number":     counterOptions.RowNumber,
+			"count_rows":     counterOptions.CountRow,
+			// Target
+			"target_column":     counterOptions.TargetColName,
+			"target_row_number": counterOptions.TargetRowNumber,
+			// Formatting
+			"string_decimal":            counterOptions.StringDecimal,
+			"string_decimal_char":       counterOptions.StringDecChar,
+			"string_thousand_separator": counterOptions.StringThouSep,
+			"string_prefix":             counterOptions.StringPrefix,
+			"string_suffix":             counterOptions.StringSuffix,
+			"format_target_value":       counterOptions.FormatTargetValue,
+			"tooltip_format":            counterOptions.TooltipFormat,
+		}}, nil
+	case "PIVOT":
+		var pivotOptions PivotOptions
+		if err := visualizationDecodeOptions(options, &pivotOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			"rows":            pivotOptions.Rows,
+			"columns":         pivotOptions.Cols,
+			"values":          pivotOptions.Vals,
+			"aggregator_name": pivotOptions.AggregatorName,
+			"renderer_name":   pivotOptions.RendererName,
+			// Display
+			"hide_controls":      pivotOptions.Controls.Enabled,
+			"show_row_totals":    pivotOptions.RendererOptions.Table.RowTotals,
+			"show_column_totals": pivotOptions.RendererOptions.Table.ColTotals,
+		}}, nil
+	case "DETAILS":
+		var detailsOptions DetailsOptions
+		if err := visualizationDecodeOptions(options, &detailsOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			"columns": lo.Map(detailsOptions.Columns, func(column DetailsColumn, _ int) map[string]interface{} {
+				return map[string]interface{}{
+					"name":       column.Name,
+					"title":      column.Title,
+					"visible":    column.Visible,
+					"order":      column.Order,
+					"display_as": column.DisplayAs,
+				}
+			}),
+		}}, nil
+	case "COHORT":
+		var cohortOptions CohortOptions
+		if err := visualizationDecodeOptions(options, &cohortOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			"time_interval": cohortOptions.TimeInterval,
+			"mode":          cohortOptions.Mode,
+			// Columns
+			"date_column":  cohortOptions.DateColumn,
+			"stage_column": cohortOptions.StageColumn,
+			"total_column": cohortOptions.TotalColumn,
+			"value_column": cohortOptions.ValueColumn,
+			// Appearance
+			"number_values_format":  cohortOptions.NumberValuesFormat,
+			"percent_values_format": cohortOptions.PercentValuesFormat,
+			"no_value_placeholder":  cohortOptions.NoValuePlaceholder,
+			"show_tooltips":         cohortOptions.ShowTooltips,
+		}}, nil
+	case "FUNNEL":
+		var funnelOptions FunnelOptions
+		if err := visualizationDecodeOptions(options, &funnelOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			// Columns
+			"step_column":      lo.FromPtr(funnelOptions.StepCol.ColName),
+			"step_display_as":  funnelOptions.StepCol.DisplayAs,
+			"value_column":     lo.FromPtr(funnelOptions.ValueCol.ColName),
+			"value_display_as": funnelOptions.ValueCol.DisplayAs,
+			// Sorting
+			"auto_sort":    funnelOptions.AutoSort,
+			"sort_column":  lo.FromPtr(funnelOptions.SortKeyCol.ColName),
+			"sort_reverse": funnelOptions.SortKeyCol.Reverse,
+			"items_limit":  funnelOptions.ItemsLimit,
+			// Appearance
+			"percent_values_range_min": funnelOptions.PercentValuesRange.Min,
+			"percent_values_range_max": funnelOptions.PercentValuesRange.Max,
+			"number_format":            funnelOptions.NumberFormat,
+			"percent_format":           funnelOptions.PercentFormat,
+		}}, nil
+	case "WORD_CLOUD":
+		var wordCloudOptions WordCloudOptions
+		if err := visualizationDecodeOptions(options, &wordCloudOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			"column":             lo.FromPtr(wordCloudOptions.Column),
+			"frequencies_column": lo.FromPtr(wordCloudOptions.FrequenciesColumn),
+			// Limits
+			"word_length_min": lo.FromPtr(wordCloudOptions.WordLengthLimit.Min),
+			"word_length_max": lo.FromPtr(wordCloudOptions.WordLengthLimit.Max),
+			"word_count_min":  lo.FromPtr(wordCloudOptions.WordCountLimit.Min),
+			"word_count_max":  lo.FromPtr(wordCloudOptions.WordCountLimit.Max),
+		}}, nil
+	case "BOXPLOT":
+		var boxplotOptions BoxplotOptions
+		if err := visualizationDecodeOptions(options, &boxplotOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			"x_axis_label": boxplotOptions.XAxisLabel,
+			"y_axis_label": boxplotOptions.YAxisLabel,
+		}}, nil
+	case "MAP":
+		var mapOptions MapOptions
+		if err := visualizationDecodeOptions(options, &mapOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			// Columns
+			"latitude_column":  mapOptions.LatColName,
+			"longitude_column": mapOptions.LonColName,
+			"group_by_column":  lo.FromPtr(mapOptions.Classify),
+			// Style
+			"map_tile_url":      mapOptions.MapTileUrl,
+			"cluster_markers":   mapOptions.ClusterMarkers,
+			"customize_markers": mapOptions.CustomizeMarkers,
+			"icon_shape":        mapOptions.IconShape,
+			"icon_font":         mapOptions.IconFont,
+			"foreground_color":  mapOptions.ForegroundColor,
+			"background_color":  mapOptions.BackgroundColor,
+			"border_color":      mapOptions.BorderColor,
+			// Format
+			"tooltip_enabled":  mapOptions.Tooltip.Enabled,
+			"tooltip_template": mapOptions.Tooltip.Template,
+			"popup_enabled":    mapOptions.Popup.Enabled,
+			"popup_template":   mapOptions.Popup.Template,
+		}}, nil
+	case "CHOROPLETH":
+		var choroplethOptions ChoroplethOptions
+		if err := visualizationDecodeOptions(options, &choroplethOptions); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{{
+			// General
+			"map_type":     choroplethOptions.MapType,
+			"key_column":   lo.FromPtr(choroplethOptions.KeyColumn),
+			"target_field": lo.FromPtr(choroplethOptions.TargetField),
+			"value_column": lo.FromPtr(choroplethOptions.ValueColumn),
+			// Colors
+			"clustering_mode":  choroplethOptions.ClusteringMode,
+			"steps":            choroplethOptions.Steps,
+			"color_min":        choroplethOptions.Colors.Min,
+			"color_max":        choroplethOptions.Colors.Max,
+			"color_no_value":   choroplethOptions.Colors.NoValue,
+			"color_background": choroplethOptions.Colors.Background,
+			"color_borders":    choroplethOptions.Colors.Borders,
+			// Format
+			"value_format":         choroplethOptions.ValueFormat,
+			"no_value_placeholder": choroplethOptions.NoValuePlaceholder,
+			"legend_visible":       choroplethOptions.Legend.Visible,
+			"legend_position":      choroplethOptions.Legend.Position,
+			"legend_align_text":    choroplethOptions.Legend.AlignText,
+			"tooltip_enabled":      choroplethOptions.Tooltip.Enabled,
+			"tooltip_template":     choroplethOptions.Tooltip.Template,
+			"popup_enabled":        choroplethOptions.Popup.Enabled,
+			"popup_template":       choroplethOptions.Popup.Template,
+		}}, nil
+	default:
+		return nil, fmt.Errorf("Visualization type %s has no typed options", vType)
+	}
 }
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestOptionsJSONNormalize(t *testing.T) {
+	cases := []struct {
+		name     string
+		old      string
+		new      string
+		defaults map[string]interface{}
+		equal    bool
+	}{
+		{
+			name:  "key order",
+			old:   `{"a":1,"b":{"c":true,"d":"x"}}`,
+			new:   `{"b":{"d":"x","c":true},"a":1}`,
+			equal: true,
+		},
+		{
+			name:  "null and empty values are unset",
+			old:   `{"a":1}`,
+			new:   `{"a":1,"b":null,"c":[],"d":{},"e":{"f":null}}`,
+			equal: true,
+		},
+		{
+			name:  "false is not unset",
+			old:   `{"a":1}`,
+			new:   `{"a":1,"b":false}`,
+			equal: false,
+		},
+		{
+			name:  "empty string is not unset",
+			old:   `{"a":1}`,
+			new:   `{"a":1,"b":""}`,
+			equal: false,
+		},
+		{
+			name:  "list items keep their position",
+			old:   `{"a":[1,null,2]}`,
+			new:   `{"a":[1,2,null]}`,
+			equal: false,
+		},
+		{
+			name:     "chart defaults",
+			old:      `{"globalSeriesType":"line"}`,
+			new:      `{"globalSeriesType":"line","legend":{"enabled":true},"textFormat":"","sortX":true}`,
+			defaults: visualizationOptionsDefaults["CHART"],
+			equal:    true,
+		},
+		{
+			name:     "counter defaults",
+			old:      `{"counterColName":"total"}`,
+			new:      `{"counterColName":"total","countRow":false,"rowNumber":1}`,
+			defaults: visualizationOptionsDefaults["COUNTER"],
+			equal:    true,
+		},
+		{
+			name:     "value different from default",
+			old:      `{}`,
+			new:      `{"legend":{"enabled":false}}`,
+			defaults: visualizationOptionsDefaults["CHART"],
+			equal:    false,
+		},
+		{
+			name:     "null is replaced by default",
+			old:      `{"legend":{"enabled":null}}`,
+			new:      `{"legend":{"enabled":true}}`,
+			defaults: visualizationOptionsDefaults["CHART"],
+			equal:    true,
+		},
+		{
+			name:     "widget defaults",
+			old:      `{"position":{"col":0,"row":3}}`,
+			new:      `{"isHidden":false,"position":{"col":0,"row":3,"sizeX":3,"sizeY":3}}`,
+			defaults: widgetOptionsDefaults,
+			equal:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldNormalized, err := optionsJSONNormalize(tc.old, tc.defaults)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			newNormalized, err := optionsJSONNormalize(tc.new, tc.defaults)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if (oldNormalized == newNormalized) != tc.equal {
+				t.Errorf("expected equal to be %t, got %s and %s", tc.equal, oldNormalized, newNormalized)
+			}
+		})
+	}
+}
+
+func TestOptionsJSONNormalizeDefaultsUnchanged(t *testing.T) {
+	if _, err := optionsJSONNormalize(`{"legend":{"enabled":false}}`, visualizationOptionsDefaults["CHART"]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	legend := visualizationOptionsDefaults["CHART"]["legend"].(map[string]interface{})
+	if legend["enabled"] != true {
+		t.Errorf("expected defaults not to be modified, got %v", legend)
+	}
+}
+
+func TestOptionsJSONNormalizeInvalid(t *testing.T) {
+	if _, err := optionsJSONNormalize(`{"a":`, nil); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+// testTableColumn returns a columns block holding the schema defaults, with the
+// given fields set
+func testTableColumn(fields map[string]interface{}) map[string]interface{} {
+	column := map[string]interface{}{
+		"visible":              true,
+		"name":                 "",
+		"title":                "",
+		"type":                 "",
+		"display_as":           "",
+		"align_content":        "",
+		"allow_search":         false,
+		"order":                0,
+		"allow_html":           false,
+		"highlight_links":      false,
+		"number_format":        "",
+		"date_time_format":     "",
+		"boolean_values":       []interface{}{},
+		"link_url_template":    "{{ @ }}",
+		"link_text_template":   "{{ @ }}",
+		"link_open_in_new_tab": true,
+		"link_title_template":  "{{ @ }}",
+		"image_url_template":   "{{ @ }}",
+		"image_title_template": "{{ @ }}",
+		"image_width":          "",
+		"image_height":         "",
+	}
+	for key, value := range fields {
+		column[key] = value
+	}
+
+	return column
+}
+
+func TestVisualizationTableColumns(t *testing.T) {
+	resultColumns := []QueryResultColumn{
+		{Name: "id", FriendlyName: "ID", Type: "integer"},
+		{Name: "created_at", FriendlyName: "Created At", Type: "datetime"},
+		{Name: "active", FriendlyName: "", Type: "boolean"},
+	}
+
+	t.Run("from query result", func(t *testing.T) {
+		columns := visualizationTableColumns(nil, resultColumns)
+		if len(columns) != 3 {
+			t.Fatalf("expected 3 columns, got %d", len(columns))
+		}
+
+		expected := []struct {
+			name, title, columnType, displayAs, alignContent string
+			order                                            int
+		}{
+			{"id", "ID", "integer", "number", "right", 100000},
+			{"created_at", "Created At", "datetime", "datetime", "left", 100001},
+			{"active", "active", "boolean", "boolean", "left", 100002},
+		}
+		for i, e := range expected {
+			c := columns[i]
+			if c.Name != e.name || c.Title != e.title || c.Type != e.columnType || c.DisplayAs != e.displayAs ||
+				c.AlignContent != e.alignContent || c.Order != e.order || !c.Visible {
+				t.Errorf("unexpected column %d: %+v", i, c)
+			}
+		}
+		if columns[0].NumberFormat != "0,0" || columns[1].DateTimeFormat != "DD/MM/YY HH:mm" {
+			t.Errorf("unexpected type defaults: %+v", columns[:2])
+		}
+	})
+
+	t.Run("configured columns override result columns", func(t *testing.T) {
+		columns := visualizationTableColumns([]map[string]interface{}{
+			testTableColumn(map[string]interface{}{"name": "id", "title": "Identifier", "visible": false}),
+			testTableColumn(map[string]interface{}{"name": "created_at", "type": "date"}),
+		}, resultColumns)
+		if len(columns) != 3 {
+			t.Fatalf("expected 3 columns, got %d", len(columns))
+		}
+
+		if columns[0].Title != "Identifier" || columns[0].Visible || columns[0].DisplayAs != "number" {
+			t.Errorf("unexpected overridden column: %+v", columns[0])
+		}
+		if columns[1].Type != "date" || columns[1].DateTimeFormat != "DD/MM/YY" {
+			t.Errorf("expected the configured type to select the defaults, got %+v", columns[1])
+		}
+	})
+
+	t.Run("configured columns missing from the result", func(t *testing.T) {
+		columns := visualizationTableColumns([]map[string]interface{}{
+			testTableColumn(map[string]interface{}{"name": "total", "type": "float"}),
+		}, resultColumns[:1])
+		if len(columns) != 2 {
+			t.Fatalf("expected 2 columns, got %d", len(columns))
+		}
+
+		if columns[1].Name != "total" || columns[1].Title != "total" || columns[1].NumberFormat != "0,0.00" || columns[1].Order != 100001 {
+			t.Errorf("unexpected configured column: %+v", columns[1])
+		}
+	})
+
+	t.Run("configured columns only", func(t *testing.T) {
+		columns := visualizationTableColumns([]map[string]interface{}{
+			testTableColumn(map[string]interface{}{"name": "name"}),
+		}, nil)
+		if len(columns) != 1 || columns[0].Type != "string" || columns[0].DisplayAs != "string" {
+			t.Errorf("unexpected columns: %+v", columns)
+		}
+	})
+}
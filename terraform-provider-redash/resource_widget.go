@@ -2,11 +2,18 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 
 	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/samber/lo"
 )
 
@@ -16,6 +23,7 @@ func resourceRedashWidget() *schema.Resource {
 		CreateContext: resourceRedashWidgetCreate,
 		UpdateContext: resourceRedashWidgetUpdate,
 		DeleteContext: resourceRedashWidgetDelete,
+		CustomizeDiff: resourceRedashWidgetCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			// Base Data
 			"widget_id": {
@@ -34,13 +42,13 @@ func resourceRedashWidget() *schema.Resource {
 			//
 			"text": {
 				Type:     schema.TypeString,
-				Required: true,
-				// Default:  "",
+				Optional: true,
+				Default:  "",
 			},
 			"width": {
 				Type:     schema.TypeInt,
-				Required: true,
-				// Default:  6,
+				Optional: true,
+				Default:  1,
 			},
 			// References
 			"visualization_id": {
@@ -48,20 +56,38 @@ func resourceRedashWidget() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			// Only used to validate parameter mappings, as Redash has no endpoint
+			// returning the query of a visualization
+			"query_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
 			// Options
+			"options_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"options"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: optionsJSONDiffSuppressFunc(widgetOptionsJSONDefaults),
+			},
 			"options": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"options_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"is_hidden": {
 							Type:     schema.TypeBool,
-							Required: true,
+							Optional: true,
+							Default:  false,
 						},
 						"parameter_mappings": {
 							Type:     schema.TypeList,
 							Optional: true,
+							Computed: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"key": {
@@ -73,12 +99,15 @@ func resourceRedashWidget() *schema.Resource {
 										Required: true,
 									},
 									"type": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(widgetParameterMappingTypes, false),
 									},
 									"map_to": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "",
+										ValidateFunc: validation.StringMatch(widgetParameterNameRegexp, "must be a parameter name made of letters, digits and underscores"),
 									},
 									"value": {
 										Type:     schema.TypeString,
@@ -86,56 +115,13 @@ func resourceRedashWidget() *schema.Resource {
 									},
 									"title": {
 										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
-						"position": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"auto_height": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"size_x": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"size_y": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"max_size_y": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"max_size_x": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"min_size_y": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"min_size_x": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"col": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"row": {
-										Type:     schema.TypeInt,
-										Required: true,
+										Optional: true,
+										Default:  "",
 									},
 								},
 							},
 						},
+						"position": widgetPositionSchema(),
 					},
 				},
 			},
@@ -167,50 +153,59 @@ func resourceRedashWidgetRead(_ context.Context, d *schema.ResourceData, meta in
 	_ = d.Set("width", widget.Width)
 	// References
 	_ = d.Set("visualization_id", widget.Visualization.ID)
+	_ = d.Set("query_id", widget.Visualization.Query.ID)
 	// Options
-	_ = d.Set("options", widget.Options)
+	if d.Get("options_json").(string) != "" {
+		options, err := getWidgetOptions(c, d.Get("dashboard_slug").(string), id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		optionsJSON, err := json.Marshal(options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("options_json", string(optionsJSON))
+	} else {
+		options := widgetFlattenOptions(widget.Options)
+
+		// Keep parameter mappings in the order they were configured in
+		var priorMappings []interface{}
+		if priorOptions := d.Get("options").([]interface{}); len(priorOptions) > 0 && priorOptions[0] != nil {
+			priorMappings, _ = priorOptions[0].(map[string]interface{})["parameter_mappings"].([]interface{})
+		}
+		options[0]["parameter_mappings"] = visualizationOrderLike(options[0]["parameter_mappings"].([]map[string]interface{}), priorMappings, "key")
+
+		_ = d.Set("options", options)
+	}
 
 	return diags
 }
 
-func resourceRedashWidgetCreate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRedashWidgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*redash.Client)
 
 	var diags diag.Diagnostics
 
+	// Widgets of a dashboard are created one at a time so that each one is
+	// placed below the widgets created before it
+	unlock := lockDashboardWidgets(c, d.Get("dashboard_slug").(string))
+	defer unlock()
+
 	dashboard, err := c.GetDashboard(d.Get("dashboard_slug").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	dOptions := d.Get("options").([]interface{})[0].(map[string]interface{})
-	dPosition := dOptions["position"].([]interface{})[0].(map[string]interface{})
-	dParameterMappings := dOptions["parameter_mappings"].([]interface{})
-
-	options := redash.WidgetOptions{
-		IsHidden: dOptions["is_hidden"].(bool),
-		Position: redash.WidgetPosition{
-			AutoHeight: dPosition["auto_height"].(bool),
-			SizeX:      dPosition["size_x"].(int),
-			SizeY:      dPosition["size_y"].(int),
-			MaxSizeY:   dPosition["max_size_y"].(int),
-			MaxSizeX:   dPosition["max_size_x"].(int),
-			MinSizeY:   dPosition["min_size_y"].(int),
-			MinSizeX:   dPosition["min_size_x"].(int),
-			Col:        dPosition["col"].(int),
-			Row:        dPosition["row"].(int),
-		},
-		ParameterMappings: lo.Associate(dParameterMappings, func(value interface{}) (string, redash.WidgetParameterMapping) {
-			paramMapping := value.(map[string]interface{})
+	options, err := resourceRedashWidgetOptions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-			return paramMapping["key"].(string), redash.WidgetParameterMapping{
-				Name:  paramMapping["name"].(string),
-				Type:  paramMapping["type"].(string),
-				MapTo: paramMapping["map_to"].(string),
-				Value: paramMapping["value"].(string),
-				Title: paramMapping["title"].(string),
-			}
-		}),
+	// Place the widget at the bottom of the dashboard when no row is given
+	if widgetOptions, ok := options.(redash.WidgetOptions); ok && !resourceRedashWidgetPositionConfigured(d, "row") {
+		widgetOptions.Position.Row = widgetBottomRow(dashboard)
+		options = widgetOptions
 	}
 
 	dVisualizationID := d.Get("visualization_id").(int)
@@ -220,7 +215,22 @@ func resourceRedashWidgetCreate(_ context.Context, d *schema.ResourceData, meta
 		visualizationID = &dVisualizationID
 	}
 
-	widget, err := c.CreateWidget(&redash.WidgetCreatePayload{
+	// Parameter mappings are validated again as the query or the dashboard may
+	// only have been known during apply
+	if _, ok := options.(redash.WidgetOptions); ok && dVisualizationID != 0 && d.Get("query_id").(int) != 0 && resourceRedashWidgetMappingsConfigured(d) {
+		query, err := widgetQuery(c, d.Get("query_id").(int), dVisualizationID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		mappings, _ := d.Get("options.0.parameter_mappings").([]interface{})
+		err = widgetValidateParameterMappings(query, dashboard, 0, mappings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	widget, err := createWidget(c, &WidgetPayload{
 		// Base Data
 		DashboardID: dashboard.ID,
 		//
@@ -239,10 +249,34 @@ func resourceRedashWidgetCreate(_ context.Context, d *schema.ResourceData, meta
 	_ = d.Set("widget_id", widget.ID)
 	_ = d.Set("dashboard_id", dashboard.ID)
 
+	// Map every query parameter to a same-named dashboard parameter unless
+	// mappings were configured
+	if widgetOptions, ok := options.(redash.WidgetOptions); ok && !resourceRedashWidgetMappingsConfigured(d) && widget.Visualization.Query.ID != 0 {
+		query, err := c.GetQuery(widget.Visualization.Query.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(query.Options.Parameters) > 0 {
+			widgetOptions.ParameterMappings = widgetDefaultParameterMappings(query.Options.Parameters)
+			_, err = updateWidget(c, widget.ID, &WidgetPayload{
+				Text:            widget.Text,
+				Width:           widget.Width,
+				VisualizationID: visualizationID,
+				Options:         widgetOptions,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	diags = append(diags, resourceRedashWidgetRead(ctx, d, meta)...)
+
 	return diags
 }
 
-func resourceRedashWidgetUpdate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceRedashWidgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*redash.Client)
 
 	var diags diag.Diagnostics
@@ -252,34 +286,9 @@ func resourceRedashWidgetUpdate(_ context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	dOptions := d.Get("options").([]interface{})[0].(map[string]interface{})
-	dPosition := dOptions["position"].([]interface{})[0].(map[string]interface{})
-	dParameterMappings := dOptions["parameter_mappings"].([]interface{})
-
-	options := redash.WidgetOptions{
-		IsHidden: dOptions["is_hidden"].(bool),
-		Position: redash.WidgetPosition{
-			AutoHeight: dPosition["auto_height"].(bool),
-			SizeX:      dPosition["size_x"].(int),
-			SizeY:      dPosition["size_y"].(int),
-			MaxSizeY:   dPosition["max_size_y"].(int),
-			MaxSizeX:   dPosition["max_size_x"].(int),
-			MinSizeY:   dPosition["min_size_y"].(int),
-			MinSizeX:   dPosition["min_size_x"].(int),
-			Col:        dPosition["col"].(int),
-			Row:        dPosition["row"].(int),
-		},
-		ParameterMappings: lo.Associate(dParameterMappings, func(value interface{}) (string, redash.WidgetParameterMapping) {
-			paramMapping := value.(map[string]interface{})
-
-			return paramMapping["key"].(string), redash.WidgetParameterMapping{
-				Name:  paramMapping["name"].(string),
-				Type:  paramMapping["type"].(string),
-				MapTo: paramMapping["map_to"].(string),
-				Value: paramMapping["value"].(string),
-				Title: paramMapping["title"].(string),
-			}
-		}),
+	options, err := resourceRedashWidgetOptions(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	dVisualizationID := d.Get("visualization_id").(int)
@@ -289,7 +298,7 @@ func resourceRedashWidgetUpdate(_ context.Context, d *schema.ResourceData, meta
 		visualizationID = &dVisualizationID
 	}
 
-	_, err = c.UpdateWidget(id, &redash.WidgetUpdatePayload{
+	_, err = updateWidget(c, id, &WidgetPayload{
 		//
 		Text:  d.Get("text").(string),
 		Width: d.Get("width").(int),
@@ -302,6 +311,8 @@ func resourceRedashWidgetUpdate(_ context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	diags = append(diags, resourceRedashWidgetRead(ctx, d, meta)...)
+
 	return diags
 }
 
@@ -324,3 +335,361 @@ func resourceRedashWidgetDelete(_ context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+// resourceRedashWidgetOptions builds the API options of the widget from either
+// the options block or the options_json attribute
+func resourceRedashWidgetOptions(d *schema.ResourceData) (interface{}, error) {
+	if optionsJSON, ok := d.GetOk("options_json"); ok {
+		return optionsJSONExpand(optionsJSON.(string))
+	}
+
+	dOptions := map[string]interface{}{}
+	if options := d.Get("options").([]interface{}); len(options) > 0 && options[0] != nil {
+		dOptions = options[0].(map[string]interface{})
+	}
+	dParameterMappings, _ := dOptions["parameter_mappings"].([]interface{})
+
+	return redash.WidgetOptions{
+		IsHidden: lo.ValueOr[string, interface{}](dOptions, "is_hidden", false).(bool),
+		Position: widgetExpandPosition(resourceRedashVisualizationNestedBlock(dOptions, "position")),
+		ParameterMappings: lo.Associate(dParameterMappings, func(value interface{}) (string, redash.WidgetParameterMapping) {
+			paramMapping := value.(map[string]interface{})
+
+			return paramMapping["key"].(string), redash.WidgetParameterMapping{
+				Name:  paramMapping["name"].(string),
+				Type:  paramMapping["type"].(string),
+				MapTo: paramMapping["map_to"].(string),
+				Value: paramMapping["value"].(string),
+				Title: paramMapping["title"].(string),
+			}
+		}),
+	}, nil
+}
+
+// resourceRedashWidgetCustomizeDiff validates the configured parameter
+// mappings of the widget against the parameters of the configured query and
+// the dashboard parameters of the other widgets of the dashboard
+func resourceRedashWidgetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	c := meta.(*redash.Client)
+
+	if !configuredInRawConfig(diff.GetRawConfig(), "options", "parameter_mappings") || diff.GetRawConfig().GetAttr("query_id").IsNull() {
+		return nil
+	}
+	if !diff.NewValueKnown("options") || !diff.NewValueKnown("visualization_id") || !diff.NewValueKnown("query_id") {
+		return nil
+	}
+
+	visualizationID := diff.Get("visualization_id").(int)
+	mappings, _ := diff.Get("options.0.parameter_mappings").([]interface{})
+	if visualizationID == 0 || len(mappings) == 0 {
+		return nil
+	}
+
+	query, err := widgetQuery(c, diff.Get("query_id").(int), visualizationID)
+	if err != nil {
+		return err
+	}
+
+	// The dashboard is unknown when it is created in the same apply
+	var dashboard *redash.Dashboard
+	if diff.NewValueKnown("dashboard_slug") {
+		dashboard, err = c.GetDashboard(diff.Get("dashboard_slug").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	widgetID, _ := strconv.Atoi(diff.Id())
+
+	return widgetValidateParameterMappings(query, dashboard, widgetID, mappings)
+}
+
+// resourceRedashWidgetMappingsConfigured reports whether parameter mappings
+// are set in the configuration
+func resourceRedashWidgetMappingsConfigured(d *schema.ResourceData) bool {
+	return configuredInRawConfig(d.GetRawConfig(), "options", "parameter_mappings")
+}
+
+// resourceRedashWidgetPositionConfigured reports whether a position attribute
+// is set in the configuration, as zero is a valid column and row
+func resourceRedashWidgetPositionConfigured(d *schema.ResourceData, key string) bool {
+	return configuredInRawConfig(d.GetRawConfig(), "options", "position", key)
+}
+
+// configuredInRawConfig reports whether the attribute at the given path is set
+// in a raw configuration, as returned by GetRawConfig. Every name but the last
+// is a block holding at most one item. Unknown values count as set
+func configuredInRawConfig(value cty.Value, path ...string) bool {
+	for i, name := range path {
+		value = value.GetAttr(name)
+		if value.IsNull() {
+			return false
+		}
+		if !value.IsKnown() || i == len(path)-1 {
+			return true
+		}
+
+		items := value.AsValueSlice()
+		if len(items) == 0 {
+			return false
+		}
+		value = items[0]
+	}
+
+	return true
+}
+
+// Widget parameter mapping types supported by Redash
+var widgetParameterMappingTypes = []string{"dashboard-level", "widget-level", "static-value"}
+
+// Matches the names Redash accepts for query and dashboard parameters
+var widgetParameterNameRegexp = regexp.MustCompile(`^\w*$`)
+
+// widgetQuery returns the query of a widget, checking that the visualization
+// of the widget belongs to it
+func widgetQuery(c *redash.Client, queryID int, visualizationID int) (*redash.Query, error) {
+	query, err := c.GetQuery(queryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lo.ContainsBy(query.Visualizations, func(visualization redash.VisualizationQuery) bool {
+		return visualization.ID == visualizationID
+	}) {
+		return nil, fmt.Errorf("visualization %d does not belong to query %d", visualizationID, queryID)
+	}
+
+	return query, nil
+}
+
+// widgetValidateParameterMappings checks parameter mappings against the
+// parameters of the widget's query. Mappings to a dashboard parameter must
+// match the type of the parameters other widgets of the dashboard map to it,
+// and map_to and value must fit the mapping type
+func widgetValidateParameterMappings(query *redash.Query, dashboard *redash.Dashboard, widgetID int, mappings []interface{}) error {
+	parameterNames := lo.Map(query.Options.Parameters, func(parameter redash.QueryOptionsParameter, _ int) string {
+		return parameter.Name
+	})
+	parameters := lo.SliceToMap(query.Options.Parameters, func(parameter redash.QueryOptionsParameter) (string, redash.QueryOptionsParameter) {
+		return parameter.Name, parameter
+	})
+
+	// Types of the dashboard parameters the other widgets map to
+	dashboardParameterTypes := map[string]string{}
+	if dashboard != nil {
+		for _, widget := range dashboard.Widgets {
+			if widget.ID == widgetID {
+				continue
+			}
+
+			for key, mapping := range widget.Options.ParameterMappings {
+				parameter, ok := lo.Find(widget.Visualization.Query.Options.Parameters, func(parameter redash.QueryOptionsParameter) bool {
+					return parameter.Name == key
+				})
+				if mapping.Type == "dashboard-level" && ok {
+					dashboardParameterTypes[mapping.MapTo] = parameter.Type
+				}
+			}
+		}
+	}
+
+	var errs []error
+	for _, item := range mappings {
+		mapping := item.(map[string]interface{})
+		key := mapping["key"].(string)
+		mapTo := mapping["map_to"].(string)
+		value := mapping["value"].(string)
+
+		parameter, ok := parameters[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("parameter mapping %q does not match a parameter of query %d, expected one of %v", key, query.ID, parameterNames))
+		}
+		if mapping["name"].(string) != key {
+			errs = append(errs, fmt.Errorf("parameter mapping %q must have the name %q", key, key))
+		}
+
+		switch mapping["type"].(string) {
+		case "dashboard-level":
+			if mapTo == "" {
+				errs = append(errs, fmt.Errorf("parameter mapping %q of type dashboard-level must set map_to to a dashboard parameter name", key))
+			} else if dashboardType, exists := dashboardParameterTypes[mapTo]; ok && exists && dashboardType != parameter.Type {
+				errs = append(errs, fmt.Errorf("parameter mapping %q maps a %s parameter to dashboard parameter %q, which other widgets map %s parameters to", key, parameter.Type, mapTo, dashboardType))
+			}
+			if value != "" {
+				errs = append(errs, fmt.Errorf("parameter mapping %q of type dashboard-level must not set value, which is only used by static-value mappings", key))
+			}
+		case "widget-level":
+			if mapTo != "" {
+				errs = append(errs, fmt.Errorf("parameter mapping %q of type widget-level must not set map_to, which is only used by dashboard-level mappings", key))
+			}
+			if value != "" {
+				errs = append(errs, fmt.Errorf("parameter mapping %q of type widget-level must not set value, which is only used by static-value mappings", key))
+			}
+		case "static-value":
+			if mapTo != "" {
+				errs = append(errs, fmt.Errorf("parameter mapping %q of type static-value must not set map_to, which is only used by dashboard-level mappings", key))
+			}
+			if value == "" {
+				errs = append(errs, fmt.Errorf("parameter mapping %q of type static-value must set value", key))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// widgetDefaultParameterMappings maps every query parameter to a dashboard
+// parameter of the same name, as the Redash UI does for new widgets
+func widgetDefaultParameterMappings(parameters []redash.QueryOptionsParameter) map[string]redash.WidgetParameterMapping {
+	return lo.SliceToMap(parameters, func(parameter redash.QueryOptionsParameter) (string, redash.WidgetParameterMapping) {
+		return parameter.Name, redash.WidgetParameterMapping{
+			Name:  parameter.Name,
+			Type:  "dashboard-level",
+			MapTo: parameter.Name,
+		}
+	})
+}
+
+// widgetFlattenOptions converts widget options into the options block
+// representation. Parameter mappings are a map in the API and are sorted by
+// key to keep the output stable
+func widgetFlattenOptions(options redash.WidgetOptions) []map[string]interface{} {
+	keys := lo.Keys(options.ParameterMappings)
+	sort.Strings(keys)
+
+	return []map[string]interface{}{{
+		"is_hidden": options.IsHidden,
+		"parameter_mappings": lo.Map(keys, func(key string, _ int) map[string]interface{} {
+			parameterMapping := options.ParameterMappings[key]
+			return map[string]interface{}{
+				"key":    key,
+				"name":   parameterMapping.Name,
+				"type":   parameterMapping.Type,
+				"map_to": parameterMapping.MapTo,
+				"value":  parameterMapping.Value,
+				"title":  parameterMapping.Title,
+			}
+		}),
+		"position": widgetFlattenPosition(options.Position),
+	}}
+}
+
+// widgetPositionSchema returns the schema of a widget position block, which
+// is shared by widget resources
+func widgetPositionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_height": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"size_x": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  3,
+				},
+				"size_y": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  3,
+				},
+				"max_size_y": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1000,
+				},
+				"max_size_x": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  6,
+				},
+				"min_size_y": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1,
+				},
+				"min_size_x": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1,
+				},
+				// Placed at the bottom of the dashboard when omitted
+				"col": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"row": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// widgetOptionsDefaults holds the widget options Redash uses when they are not
+// set, merged into both sides of an options_json comparison
+var widgetOptionsDefaults = map[string]interface{}{
+	"isHidden": false,
+	"position": map[string]interface{}{
+		"autoHeight": false,
+		"sizeX":      3,
+		"sizeY":      3,
+		"maxSizeY":   1000,
+		"maxSizeX":   6,
+		"minSizeY":   1,
+		"minSizeX":   1,
+	},
+}
+
+// widgetOptionsJSONDefaults returns the option defaults of widgets
+func widgetOptionsJSONDefaults(_ *schema.ResourceData) map[string]interface{} {
+	return widgetOptionsDefaults
+}
+
+// widgetExpandPosition converts a position block into the API widget
+// position, using the Redash defaults for missing attributes
+func widgetExpandPosition(dPosition map[string]interface{}) redash.WidgetPosition {
+	return redash.WidgetPosition{
+		AutoHeight: lo.ValueOr[string, interface{}](dPosition, "auto_height", false).(bool),
+		SizeX:      lo.ValueOr[string, interface{}](dPosition, "size_x", 3).(int),
+		SizeY:      lo.ValueOr[string, interface{}](dPosition, "size_y", 3).(int),
+		MaxSizeY:   lo.ValueOr[string, interface{}](dPosition, "max_size_y", 1000).(int),
+		MaxSizeX:   lo.ValueOr[string, interface{}](dPosition, "max_size_x", 6).(int),
+		MinSizeY:   lo.ValueOr[string, interface{}](dPosition, "min_size_y", 1).(int),
+		MinSizeX:   lo.ValueOr[string, interface{}](dPosition, "min_size_x", 1).(int),
+		Col:        lo.ValueOr[string, interface{}](dPosition, "col", 0).(int),
+		Row:        lo.ValueOr[string, interface{}](dPosition, "row", 0).(int),
+	}
+}
+
+// widgetFlattenPosition converts an API widget position into the position
+// block representation
+func widgetFlattenPosition(position redash.WidgetPosition) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"auto_height": position.AutoHeight,
+		"size_x":      position.SizeX,
+		"size_y":      position.SizeY,
+		"max_size_y":  position.MaxSizeY,
+		"max_size_x":  position.MaxSizeX,
+		"min_size_y":  position.MinSizeY,
+		"min_size_x":  position.MinSizeX,
+		"col":         position.Col,
+		"row":         position.Row,
+	}}
+}
+
+// widgetBottomRow returns the first free row below all widgets of a dashboard
+func widgetBottomRow(dashboard *redash.Dashboard) int {
+	return lo.Max(lo.Map(dashboard.Widgets, func(widget redash.WidgetDashboard, _ int) int {
+		return widget.Options.Position.Row + widget.Options.Position.SizeY
+	}))
+}
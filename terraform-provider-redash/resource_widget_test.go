@@ -0,0 +1,262 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AlmirKadric/redash-client-go/redash"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// testWidget returns a dashboard widget at the given position
+func testWidget(col, row, sizeX, sizeY int) redash.WidgetDashboard {
+	widget := redash.WidgetDashboard{}
+	widget.Options.Position = redash.WidgetPosition{Col: col, Row: row, SizeX: sizeX, SizeY: sizeY}
+
+	return widget
+}
+
+func TestWidgetBottomRow(t *testing.T) {
+	cases := []struct {
+		name    string
+		widgets []redash.WidgetDashboard
+		row     int
+	}{
+		{
+			name: "empty dashboard",
+			row:  0,
+		},
+		{
+			name:    "single widget",
+			widgets: []redash.WidgetDashboard{testWidget(0, 0, 3, 8)},
+			row:     8,
+		},
+		{
+			name:    "side by side widgets",
+			widgets: []redash.WidgetDashboard{testWidget(0, 0, 3, 8), testWidget(3, 0, 3, 5)},
+			row:     8,
+		},
+		{
+			name:    "lowest widget is not the last one",
+			widgets: []redash.WidgetDashboard{testWidget(0, 10, 6, 4), testWidget(0, 0, 3, 3)},
+			row:     14,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if row := widgetBottomRow(&redash.Dashboard{Widgets: tc.widgets}); row != tc.row {
+				t.Errorf("expected row %d, got %d", tc.row, row)
+			}
+		})
+	}
+}
+
+func TestLockDashboardWidgets(t *testing.T) {
+	c := &redash.Client{}
+
+	unlock := lockDashboardWidgets(c, "sales")
+
+	locked := make(chan struct{})
+	go func() {
+		defer lockDashboardWidgets(c, "sales")()
+		close(locked)
+	}()
+
+	// Other dashboards are not locked
+	lockDashboardWidgets(c, "marketing")()
+
+	select {
+	case <-locked:
+		t.Fatal("expected the dashboard to stay locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("expected the dashboard to be unlocked")
+	}
+}
+
+// testParameterMapping returns a parameter_mappings block for the given key
+func testParameterMapping(key, mappingType, mapTo, value string) map[string]interface{} {
+	return map[string]interface{}{
+		"key":    key,
+		"name":   key,
+		"type":   mappingType,
+		"map_to": mapTo,
+		"value":  value,
+		"title":  "",
+	}
+}
+
+func TestWidgetValidateParameterMappings(t *testing.T) {
+	query := &redash.Query{ID: 7}
+	query.Options.Parameters = []redash.QueryOptionsParameter{
+		{Name: "country", Type: "text"},
+		{Name: "limit", Type: "number"},
+	}
+
+	// Widget 2 maps a text parameter to the dashboard parameter "region"
+	other := testWidget(0, 0, 3, 3)
+	other.ID = 2
+	other.Options.ParameterMappings = map[string]redash.WidgetParameterMapping{
+		"region": {Name: "region", Type: "dashboard-level", MapTo: "region"},
+	}
+	other.Visualization.Query.Options.Parameters = []redash.QueryOptionsParameter{{Name: "region", Type: "text"}}
+	dashboard := &redash.Dashboard{Widgets: []redash.WidgetDashboard{other}}
+
+	cases := []struct {
+		name     string
+		mapping  map[string]interface{}
+		widgetID int
+		err      string
+	}{
+		{
+			name:    "dashboard-level",
+			mapping: testParameterMapping("country", "dashboard-level", "country", ""),
+		},
+		{
+			name:    "dashboard-level with a matching dashboard parameter type",
+			mapping: testParameterMapping("country", "dashboard-level", "region", ""),
+		},
+		{
+			name:    "widget-level",
+			mapping: testParameterMapping("country", "widget-level", "", ""),
+		},
+		{
+			name:    "static-value",
+			mapping: testParameterMapping("limit", "static-value", "", "100"),
+		},
+		{
+			name:    "unknown key",
+			mapping: testParameterMapping("contry", "widget-level", "", ""),
+			err:     `parameter mapping "contry" does not match a parameter of query 7`,
+		},
+		{
+			name: "name different from key",
+			mapping: func() map[string]interface{} {
+				mapping := testParameterMapping("country", "widget-level", "", "")
+				mapping["name"] = "Country"
+				return mapping
+			}(),
+			err: `must have the name "country"`,
+		},
+		{
+			name:    "dashboard-level without map_to",
+			mapping: testParameterMapping("country", "dashboard-level", "", ""),
+			err:     "must set map_to",
+		},
+		{
+			name:    "dashboard-level with a value",
+			mapping: testParameterMapping("country", "dashboard-level", "country", "fr"),
+			err:     "must not set value",
+		},
+		{
+			name:    "dashboard-level with a different dashboard parameter type",
+			mapping: testParameterMapping("limit", "dashboard-level", "region", ""),
+			err:     `maps a number parameter to dashboard parameter "region", which other widgets map text parameters to`,
+		},
+		{
+			name:     "dashboard parameter type of the widget itself is ignored",
+			mapping:  testParameterMapping("limit", "dashboard-level", "region", ""),
+			widgetID: 2,
+		},
+		{
+			name:    "widget-level with map_to",
+			mapping: testParameterMapping("country", "widget-level", "country", ""),
+			err:     "must not set map_to",
+		},
+		{
+			name:    "static-value without value",
+			mapping: testParameterMapping("limit", "static-value", "", ""),
+			err:     "must set value",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := widgetValidateParameterMappings(query, dashboard, tc.widgetID, []interface{}{tc.mapping})
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+
+	t.Run("unknown dashboard", func(t *testing.T) {
+		err := widgetValidateParameterMappings(query, nil, 0, []interface{}{
+			testParameterMapping("limit", "dashboard-level", "region", ""),
+		})
+		if err != nil {
+			t.Errorf("expected no error, got %s", err)
+		}
+	})
+}
+
+func TestWidgetDefaultParameterMappings(t *testing.T) {
+	mappings := widgetDefaultParameterMappings([]redash.QueryOptionsParameter{
+		{Name: "country", Type: "text"},
+		{Name: "limit", Type: "number"},
+	})
+
+	if len(mappings) != 2 {
+		t.Fatalf("expected 2 mappings, got %d", len(mappings))
+	}
+	for _, name := range []string{"country", "limit"} {
+		expected := redash.WidgetParameterMapping{Name: name, Type: "dashboard-level", MapTo: name}
+		if mappings[name] != expected {
+			t.Errorf("expected %+v, got %+v", expected, mappings[name])
+		}
+	}
+
+	if mappings := widgetDefaultParameterMappings(nil); len(mappings) != 0 {
+		t.Errorf("expected no mappings, got %+v", mappings)
+	}
+}
+
+func TestConfiguredInRawConfig(t *testing.T) {
+	position := func(row cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"options": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"position": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"col": cty.NullVal(cty.Number),
+					"row": row,
+				})}),
+			})}),
+		})
+	}
+	noOptions := cty.ObjectVal(map[string]cty.Value{
+		"options": cty.ListValEmpty(cty.Object(map[string]cty.Type{"position": cty.List(cty.Object(map[string]cty.Type{}))})),
+	})
+
+	cases := []struct {
+		name       string
+		config     cty.Value
+		path       []string
+		configured bool
+	}{
+		{"set", position(cty.NumberIntVal(0)), []string{"options", "position", "row"}, true},
+		{"null", position(cty.NumberIntVal(0)), []string{"options", "position", "col"}, false},
+		{"unknown", position(cty.UnknownVal(cty.Number)), []string{"options", "position", "row"}, true},
+		{"block", position(cty.NumberIntVal(0)), []string{"options", "position"}, true},
+		{"empty block list", noOptions, []string{"options", "position", "row"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if configured := configuredInRawConfig(tc.config, tc.path...); configured != tc.configured {
+				t.Errorf("expected %t, got %t", tc.configured, configured)
+			}
+		})
+	}
+}